@@ -0,0 +1,72 @@
+package sequencer
+
+import (
+	"path/filepath"
+
+	"github.com/astriaorg/astria-cli-go/modules/cli/cmd"
+	"github.com/astriaorg/astria-cli-go/modules/cli/internal/sequencer"
+	"github.com/astriaorg/astria-cli-go/modules/cli/internal/ui"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+// assetCmd represents the asset command
+var assetCmd = &cobra.Command{
+	Use:   "asset",
+	Short: "Asset commands.",
+}
+
+// assetInfoCmd represents the `asset info` command
+var assetInfoCmd = &cobra.Command{
+	Use:   "info [denom|id]",
+	Short: "Convert between an asset's denom and its ID.",
+	Long: `Show the trace-prefixed denom, ID and IBC-prefixed denom of an asset. The
+asset can be given by its trace-prefixed denom, ie. "transfer/channel-0/utia",
+whose ID is computed locally, or by its ID as hex or as an IBC-prefixed denom,
+ie. "ibc/<hex id>", which is resolved to its denom by the sequencer. Resolved
+denoms are cached in ~/.astria.`,
+	Args: cobra.ExactArgs(1),
+	Run:  assetInfoCmdHandler,
+}
+
+func assetInfoCmdHandler(c *cobra.Command, args []string) {
+	flagHandler := cmd.CreateCliFlagHandlerWithUseConfigFlag(c, cmd.EnvPrefix, "network")
+	networkConfig := GetNetworkConfigFromFlags(flagHandler)
+	flagHandler.SetConfig(networkConfig)
+
+	printJSON := flagHandler.GetValue("json") == "true"
+	sequencerURL := flagHandler.GetValue("sequencer-url")
+	sequencerURL = AddPortToURL(sequencerURL)
+
+	opts := sequencer.AssetInfoOpts{
+		SequencerURL:   sequencerURL,
+		AssetCachePath: assetCachePath(),
+		Asset:          args[0],
+	}
+	info, err := sequencer.GetAssetInfo(opts)
+	if err != nil {
+		log.WithError(err).Error("Error getting asset info")
+		panic(err)
+	}
+
+	printer := ui.ResultsPrinter{
+		Data:      info,
+		PrintJSON: printJSON,
+	}
+	printer.Render()
+}
+
+// assetCachePath returns the path of the local cache of resolved asset IDs.
+func assetCachePath() string {
+	return filepath.Join(cmd.GetUserHomeDirOrPanic(), DefaultConfigDirName, DefaultAssetCacheFilename)
+}
+
+func init() {
+	SequencerCmd.AddCommand(assetCmd)
+
+	assetCmd.AddCommand(assetInfoCmd)
+	flagHandler := cmd.CreateCliFlagHandler(assetInfoCmd, cmd.EnvPrefix)
+	flagHandler.BindStringFlag("network", cmd.DefaultTargetNetwork, "Configure the values to target a specific network.")
+	flagHandler.BindStringPFlag("sequencer-url", "u", DefaultSequencerURL, "The URL of the sequencer to resolve asset IDs with.")
+	flagHandler.BindBoolFlag("json", false, "Output the asset info in JSON format.")
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/astriaorg/astria-cli-go/modules/cli/cmd"
+	"github.com/astriaorg/astria-cli-go/modules/cli/internal/asset"
 	"github.com/astriaorg/astria-cli-go/modules/cli/internal/sequencer"
 	"github.com/astriaorg/astria-cli-go/modules/cli/internal/ui"
 	log "github.com/sirupsen/logrus"
@@ -16,8 +17,15 @@ import (
 var balancesCmd = &cobra.Command{
 	Use:   "balances [address]",
 	Short: "Retrieves and prints the balances of an account.",
-	Args:  cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
-	Run:   balancesCmdHandler,
+	Long: `Retrieves and prints the balances of an account. Assets the sequencer
+reports by their IBC-prefixed denom, ie. "ibc/<hex id>", are shown with their
+trace-prefixed denom, which is resolved by the sequencer and cached in
+~/.astria.
+
+Use --human to show balances in the display unit configured for each asset in
+the network config, ie. "1.5 TIA" instead of "1500000".`,
+	Args: cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+	Run:  balancesCmdHandler,
 }
 
 func init() {
@@ -27,6 +35,7 @@ func init() {
 	flagHandler.BindStringFlag("network", cmd.DefaultTargetNetwork, "Configure the values to target a specific network.")
 	flagHandler.BindStringPFlag("sequencer-url", "u", DefaultSequencerURL, "The URL of the sequencer to retrieve the balance from.")
 	flagHandler.BindBoolFlag("json", false, "Output an account's balances in JSON format.")
+	flagHandler.BindBoolFlag("human", false, "Show balances in the display unit of each asset, ie. \"1.5 TIA\", where one is configured.")
 
 	viper.RegisterAlias("balance", "balances")
 }
@@ -51,6 +60,12 @@ func balancesCmdHandler(c *cobra.Command, args []string) {
 		log.WithError(err).Error("Error getting balances")
 		panic(err)
 	}
+	sequencer.ResolveBalanceDenoms(balances, sequencerURL, assetCachePath())
+	if flagHandler.GetValue("human") == "true" {
+		for _, b := range *balances {
+			b.Display = asset.FormatAmount(b.Balance, networkConfig.AssetUnits(b.Denom))
+		}
+	}
 
 	printer := ui.ResultsPrinter{
 		Data:      balances,
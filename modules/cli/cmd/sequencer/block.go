@@ -3,6 +3,7 @@ package sequencer
 import (
 	"strconv"
 
+	"github.com/astriaorg/astria-cli-go/modules/bech32m"
 	"github.com/astriaorg/astria-cli-go/modules/cli/cmd"
 	"github.com/astriaorg/astria-cli-go/modules/cli/internal/sequencer"
 	"github.com/astriaorg/astria-cli-go/modules/cli/internal/ui"
@@ -14,8 +15,16 @@ import (
 var blockCmd = &cobra.Command{
 	Use:   "block [height]",
 	Short: "Get sequencer block at specified height.",
-	Args:  cobra.ExactArgs(1),
-	Run:   blockCmdHandler,
+	Long: `Get the sequencer block at the specified height and decode its
+transactions, showing the signer, nonce and actions of each one, and the
+rollup commitments at the start of the block. Use --action, --address and
+--rollup to only show matching transactions, or --raw to print the block as
+returned by CometBFT.
+
+With --json the block is printed as returned by CometBFT, unless --decode or a
+filter is set, in which case the decoded transactions are printed as JSON.`,
+	Args: cobra.ExactArgs(1),
+	Run:  blockCmdHandler,
 }
 
 func init() {
@@ -25,6 +34,11 @@ func init() {
 	flagHandler.BindStringFlag("network", cmd.DefaultTargetNetwork, "Configure the values to target a specific network.")
 	flagHandler.BindStringPFlag("sequencer-url", "u", DefaultSequencerURL, "The URL of the sequencer to retrieve the block from.")
 	flagHandler.BindBoolFlag("json", false, "Output the block in JSON format.")
+	flagHandler.BindBoolFlag("raw", false, "Output the block as returned by CometBFT, without decoding transactions.")
+	flagHandler.BindBoolFlag("decode", false, "Output the decoded transactions in JSON format. Implied by --action, --address and --rollup.")
+	flagHandler.BindStringFlag("action", "", "Only show transactions containing an action of this type, ie. \"transfer\".")
+	flagHandler.BindStringFlag("address", "", "Only show transactions signed by or referencing this address.")
+	flagHandler.BindStringFlag("rollup", "", "Only show transactions referencing this rollup, by name or hex encoded ID.")
 }
 
 func blockCmdHandler(c *cobra.Command, args []string) {
@@ -42,9 +56,24 @@ func blockCmdHandler(c *cobra.Command, args []string) {
 		panic(err)
 	}
 
+	address := flagHandler.GetValue("address")
+	if address != "" {
+		if err := bech32m.Validate(address); err != nil {
+			log.WithError(err).Errorf("Invalid address: %s", address)
+			panic(err)
+		}
+	}
+
+	filter := sequencer.DecodedTxFilter{
+		ActionType: flagHandler.GetValue("action"),
+		Address:    address,
+		Rollup:     flagHandler.GetValue("rollup"),
+	}
 	opts := sequencer.BlockOpts{
-		SequencerURL: sequencerURL,
-		BlockHeight:  height,
+		AddressPrefix: DefaultAddressPrefix,
+		SequencerURL:  sequencerURL,
+		BlockHeight:   height,
+		Filter:        filter,
 	}
 	block, err := sequencer.GetBlock(opts)
 	if err != nil {
@@ -52,8 +81,15 @@ func blockCmdHandler(c *cobra.Command, args []string) {
 		panic(err)
 	}
 
+	var data ui.Printable = block
+	switch {
+	case flagHandler.GetValue("raw") == "true":
+		data = &sequencer.RawBlockResponse{Block: block.Block}
+	case flagHandler.GetValue("decode") == "true" || !filter.IsEmpty():
+		data = &sequencer.DecodedBlockResponse{BlockResponse: block}
+	}
 	printer := ui.ResultsPrinter{
-		Data:      block,
+		Data:      data,
 		PrintJSON: printJSON,
 	}
 	printer.Render()
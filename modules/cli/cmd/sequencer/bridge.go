@@ -2,8 +2,11 @@ package sequencer
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
+	primproto "buf.build/gen/go/astria/primitives/protocolbuffers/go/astria/primitive/v1"
+	txproto "buf.build/gen/go/astria/protocol-apis/protocolbuffers/go/astria/protocol/transaction/v1"
 	"github.com/astriaorg/astria-cli-go/modules/cli/cmd"
 	"github.com/astriaorg/astria-cli-go/modules/cli/internal/sequencer"
 	"github.com/astriaorg/astria-cli-go/modules/cli/internal/ui"
@@ -38,7 +41,7 @@ func bridgeInitCmdHandler(c *cobra.Command, args []string) {
 	sequencerChainID := flagHandler.GetValue("sequencer-chain-id")
 	asset := flagHandler.GetValue("asset")
 	feeAsset := flagHandler.GetValue("fee-asset")
-	isAsync := flagHandler.GetValue("async") == "true"
+	wait := waitOptionsFromFlags(flagHandler)
 
 	priv, err := GetPrivateKeyFromFlags(c)
 	if err != nil {
@@ -78,7 +81,8 @@ func bridgeInitCmdHandler(c *cobra.Command, args []string) {
 	withdrawerAddress := AddressFromText(wa)
 
 	opts := sequencer.InitBridgeOpts{
-		IsAsync:           isAsync,
+		Wait:              wait,
+		UseNonceManager:   flagHandler.GetValue("nonce-manager") == "true",
 		AddressPrefix:     DefaultAddressPrefix,
 		SequencerURL:      sequencerURL,
 		FromKey:           from,
@@ -89,6 +93,17 @@ func bridgeInitCmdHandler(c *cobra.Command, args []string) {
 		SudoAddress:       sudoAddress,
 		WithdrawerAddress: withdrawerAddress,
 	}
+	if flagHandler.GetValue("dry-run") == "true" {
+		renderDryRun(sequencer.DryRunOpts{
+			AddressPrefix:    DefaultAddressPrefix,
+			SequencerURL:     sequencerURL,
+			FromKey:          from,
+			SequencerChainID: sequencerChainID,
+			Actions:          []*txproto.Action{sequencer.InitBridgeAccountAction(opts)},
+		}, printJSON)
+		return
+	}
+
 	bridgeAccount, err := sequencer.InitBridgeAccount(opts)
 	if err != nil {
 		log.WithError(err).Error("Error initializing bridge account")
@@ -108,7 +123,12 @@ var bridgeLockCmd = &cobra.Command{
 	Short: "Lock tokens on the bridge account",
 	Long: `A bridge lock is a transfer of tokens from the signing Sequencer
 account to a Sequencer bridge account. These tokens will then be
-bridged to a destination chain address if an IBC relayer is running.`,
+bridged to a destination chain address if an IBC relayer is running.
+
+The amount is in base units of the asset, ie. "1500000" or "1500000utia", or
+in its display unit, ie. "1.5TIA", if one is configured for the asset in the
+network config. Amounts with more decimal places than the unit allows are
+rejected.`,
 	Args: cobra.ExactArgs(3),
 	Run:  bridgeLockCmdHandler,
 }
@@ -124,7 +144,7 @@ func bridgeLockCmdHandler(c *cobra.Command, args []string) {
 	sequencerChainID := flagHandler.GetValue("sequencer-chain-id")
 	asset := flagHandler.GetValue("asset")
 	feeAsset := flagHandler.GetValue("fee-asset")
-	isAsync := flagHandler.GetValue("async") == "true"
+	wait := waitOptionsFromFlags(flagHandler)
 
 	priv, err := GetPrivateKeyFromFlags(c)
 	if err != nil {
@@ -137,9 +157,9 @@ func bridgeLockCmdHandler(c *cobra.Command, args []string) {
 		panic(err)
 	}
 
-	amount, err := convertToUint128(args[0])
+	amount, err := amountFromText(args[0], networkConfig.AssetUnits(asset))
 	if err != nil {
-		log.WithError(err).Error("Error converting amount to Uint128 proto")
+		log.WithError(err).Error("Error parsing amount")
 		panic(err)
 	}
 
@@ -149,7 +169,8 @@ func bridgeLockCmdHandler(c *cobra.Command, args []string) {
 	destinationChainAddress := args[2]
 
 	opts := sequencer.BridgeLockOpts{
-		IsAsync:                 isAsync,
+		Wait:                    wait,
+		UseNonceManager:         flagHandler.GetValue("nonce-manager") == "true",
 		AddressPrefix:           DefaultAddressPrefix,
 		SequencerURL:            sequencerURL,
 		FromKey:                 from,
@@ -160,6 +181,17 @@ func bridgeLockCmdHandler(c *cobra.Command, args []string) {
 		FeeAsset:                feeAsset,
 		DestinationChainAddress: destinationChainAddress,
 	}
+	if flagHandler.GetValue("dry-run") == "true" {
+		renderDryRun(sequencer.DryRunOpts{
+			AddressPrefix:    DefaultAddressPrefix,
+			SequencerURL:     sequencerURL,
+			FromKey:          from,
+			SequencerChainID: sequencerChainID,
+			Actions:          []*txproto.Action{sequencer.BridgeLockAction(opts)},
+		}, printJSON)
+		return
+	}
+
 	tx, err := sequencer.BridgeLock(opts)
 	if err != nil {
 		log.WithError(err).Error("Error locking tokens")
@@ -173,6 +205,228 @@ func bridgeLockCmdHandler(c *cobra.Command, args []string) {
 	printer.Render()
 }
 
+// bridgeUnlockCmd represents the `bridge unlock` command
+var bridgeUnlockCmd = &cobra.Command{
+	Use:   "unlock [amount] [to] --bridge-address --rollup-block-number --rollup-withdrawal-event-id [--keyfile | --keyring-address | --privkey]",
+	Short: "Unlock tokens from a bridge account",
+	Long: `A bridge unlock is a transfer of tokens from a Sequencer bridge account
+to a Sequencer account. It is signed by the bridge account's withdrawer and is
+used to release funds that were withdrawn on the rollup.`,
+	Args: cobra.ExactArgs(2),
+	Run:  bridgeUnlockCmdHandler,
+}
+
+func bridgeUnlockCmdHandler(c *cobra.Command, args []string) {
+	flagHandler := cmd.CreateCliFlagHandlerWithUseConfigFlag(c, cmd.EnvPrefix, "network")
+	networkConfig := GetNetworkConfigFromFlags(flagHandler)
+	flagHandler.SetConfig(networkConfig)
+
+	printJSON := flagHandler.GetValue("json") == "true"
+	sequencerURL := flagHandler.GetValue("sequencer-url")
+	sequencerURL = AddPortToURL(sequencerURL)
+	sequencerChainID := flagHandler.GetValue("sequencer-chain-id")
+	feeAsset := flagHandler.GetValue("fee-asset")
+	wait := waitOptionsFromFlags(flagHandler)
+	memo := flagHandler.GetValue("memo")
+	rollupWithdrawalEventID := flagHandler.GetValue("rollup-withdrawal-event-id")
+
+	priv, err := GetPrivateKeyFromFlags(c)
+	if err != nil {
+		log.WithError(err).Error("Could not get private key from flags")
+		panic(err)
+	}
+	from, err := PrivateKeyFromText(priv)
+	if err != nil {
+		log.WithError(err).Error("Error decoding private key")
+		panic(err)
+	}
+
+	amount, err := convertToUint128(args[0])
+	if err != nil {
+		log.WithError(err).Error("Error converting amount to Uint128 proto")
+		panic(err)
+	}
+
+	to := args[1]
+	if !strings.HasPrefix(to, DefaultAddressPrefix) {
+		log.Errorf("to address does not have the expected prefix: %s, address: %s", DefaultAddressPrefix, to)
+		panic(fmt.Errorf("to address does not have the expected prefix: %s", DefaultAddressPrefix))
+	}
+	toAddress := AddressFromText(to)
+
+	ba := flagHandler.GetValue("bridge-address")
+	if !strings.HasPrefix(ba, DefaultAddressPrefix) {
+		log.Errorf("bridge address does not have the expected prefix: %s, address: %s", DefaultAddressPrefix, ba)
+		panic(fmt.Errorf("bridge address does not have the expected prefix: %s", DefaultAddressPrefix))
+	}
+	bridgeAddress := AddressFromText(ba)
+
+	rollupBlockNumber, err := strconv.ParseUint(flagHandler.GetValue("rollup-block-number"), 10, 64)
+	if err != nil {
+		log.WithError(err).Error("Error parsing rollup block number to uint64")
+		panic(err)
+	}
+
+	opts := sequencer.BridgeUnlockOpts{
+		Wait:                    wait,
+		UseNonceManager:         flagHandler.GetValue("nonce-manager") == "true",
+		AddressPrefix:           DefaultAddressPrefix,
+		SequencerURL:            sequencerURL,
+		FromKey:                 from,
+		Amount:                  amount,
+		ToAddress:               toAddress,
+		BridgeAddress:           bridgeAddress,
+		SequencerChainID:        sequencerChainID,
+		FeeAsset:                feeAsset,
+		Memo:                    memo,
+		RollupBlockNumber:       rollupBlockNumber,
+		RollupWithdrawalEventId: rollupWithdrawalEventID,
+	}
+	tx, err := sequencer.BridgeUnlock(opts)
+	if err != nil {
+		log.WithError(err).Error("Error unlocking tokens")
+		panic(err)
+	}
+
+	printer := ui.ResultsPrinter{
+		Data:      tx,
+		PrintJSON: printJSON,
+	}
+	printer.Render()
+}
+
+// bridgeSudoChangeCmd represents the `bridge sudo-change` command
+var bridgeSudoChangeCmd = &cobra.Command{
+	Use:   "sudo-change [bridge-address] [--new-sudo-address | --new-withdrawer-address] [--keyfile | --keyring-address | --privkey]",
+	Short: "Change the sudo or withdrawer address of a bridge account",
+	Long: `Change the sudo address, the withdrawer address, or both for a bridge
+account. The transaction must be signed by the bridge account's current sudo
+address. Addresses that are not set are left unchanged.`,
+	Args: cobra.ExactArgs(1),
+	Run:  bridgeSudoChangeCmdHandler,
+}
+
+func bridgeSudoChangeCmdHandler(c *cobra.Command, args []string) {
+	flagHandler := cmd.CreateCliFlagHandlerWithUseConfigFlag(c, cmd.EnvPrefix, "network")
+	networkConfig := GetNetworkConfigFromFlags(flagHandler)
+	flagHandler.SetConfig(networkConfig)
+
+	printJSON := flagHandler.GetValue("json") == "true"
+	sequencerURL := flagHandler.GetValue("sequencer-url")
+	sequencerURL = AddPortToURL(sequencerURL)
+	sequencerChainID := flagHandler.GetValue("sequencer-chain-id")
+	feeAsset := flagHandler.GetValue("fee-asset")
+	wait := waitOptionsFromFlags(flagHandler)
+
+	priv, err := GetPrivateKeyFromFlags(c)
+	if err != nil {
+		log.WithError(err).Error("Could not get private key from flags")
+		panic(err)
+	}
+	from, err := PrivateKeyFromText(priv)
+	if err != nil {
+		log.WithError(err).Error("Error decoding private key")
+		panic(err)
+	}
+
+	ba := args[0]
+	if !strings.HasPrefix(ba, DefaultAddressPrefix) {
+		log.Errorf("bridge address does not have the expected prefix: %s, address: %s", DefaultAddressPrefix, ba)
+		panic(fmt.Errorf("bridge address does not have the expected prefix: %s", DefaultAddressPrefix))
+	}
+	bridgeAddress := AddressFromText(ba)
+
+	// the sudo and withdrawer addresses are only changed when set
+	var sudoAddress *primproto.Address
+	sa := flagHandler.GetValue("new-sudo-address")
+	if sa != "" {
+		if !strings.HasPrefix(sa, DefaultAddressPrefix) {
+			log.Errorf("sudo address does not have the expected prefix: %s, address: %s", DefaultAddressPrefix, sa)
+			panic(fmt.Errorf("sudo address does not have the expected prefix: %s", DefaultAddressPrefix))
+		}
+		sudoAddress = AddressFromText(sa)
+	}
+
+	var withdrawerAddress *primproto.Address
+	wa := flagHandler.GetValue("new-withdrawer-address")
+	if wa != "" {
+		if !strings.HasPrefix(wa, DefaultAddressPrefix) {
+			log.Errorf("withdrawer address does not have the expected prefix: %s, address: %s", DefaultAddressPrefix, wa)
+			panic(fmt.Errorf("withdrawer address does not have the expected prefix: %s", DefaultAddressPrefix))
+		}
+		withdrawerAddress = AddressFromText(wa)
+	}
+
+	opts := sequencer.BridgeSudoChangeOpts{
+		Wait:                 wait,
+		UseNonceManager:      flagHandler.GetValue("nonce-manager") == "true",
+		AddressPrefix:        DefaultAddressPrefix,
+		SequencerURL:         sequencerURL,
+		FromKey:              from,
+		SequencerChainID:     sequencerChainID,
+		FeeAsset:             feeAsset,
+		BridgeAddress:        bridgeAddress,
+		NewSudoAddress:       sudoAddress,
+		NewWithdrawerAddress: withdrawerAddress,
+	}
+	tx, err := sequencer.BridgeSudoChange(opts)
+	if err != nil {
+		log.WithError(err).Error("Error changing bridge sudo address")
+		panic(err)
+	}
+
+	printer := ui.ResultsPrinter{
+		Data:      tx,
+		PrintJSON: printJSON,
+	}
+	printer.Render()
+}
+
+// bridgeInfoCmd represents the `bridge info` command
+var bridgeInfoCmd = &cobra.Command{
+	Use:   "info [bridge-address]",
+	Short: "Show the configuration and last withdrawal of a bridge account",
+	Long: `Show the rollup ID, asset, sudo address and withdrawer address of a bridge
+account, along with the hash of the last transaction that used it. If that
+transaction withdrew from the bridge account, the withdrawal is shown as well,
+including the rollup block number and withdrawal event ID for unlocks.`,
+	Args: cobra.ExactArgs(1),
+	Run:  bridgeInfoCmdHandler,
+}
+
+func bridgeInfoCmdHandler(c *cobra.Command, args []string) {
+	flagHandler := cmd.CreateCliFlagHandlerWithUseConfigFlag(c, cmd.EnvPrefix, "network")
+	networkConfig := GetNetworkConfigFromFlags(flagHandler)
+	flagHandler.SetConfig(networkConfig)
+
+	printJSON := flagHandler.GetValue("json") == "true"
+	sequencerURL := flagHandler.GetValue("sequencer-url")
+	sequencerURL = AddPortToURL(sequencerURL)
+
+	ba := args[0]
+	if !strings.HasPrefix(ba, DefaultAddressPrefix) {
+		log.Errorf("bridge address does not have the expected prefix: %s, address: %s", DefaultAddressPrefix, ba)
+		panic(fmt.Errorf("bridge address does not have the expected prefix: %s", DefaultAddressPrefix))
+	}
+
+	opts := sequencer.BridgeInfoOpts{
+		AddressPrefix: DefaultAddressPrefix,
+		SequencerURL:  sequencerURL,
+		BridgeAddress: ba,
+	}
+	info, err := sequencer.GetBridgeInfo(opts)
+	if err != nil {
+		log.WithError(err).Error("Error getting bridge info")
+		panic(err)
+	}
+
+	printer := ui.ResultsPrinter{
+		Data:      info,
+		PrintJSON: printJSON,
+	}
+	printer.Render()
+}
+
 func init() {
 	SequencerCmd.AddCommand(bridgeCmd)
 
@@ -186,7 +440,9 @@ func init() {
 	bifh.BindStringFlag("withdrawer-address", "", "Set the withdrawer address to use for the bridge account. The address of the sender is used if this is not set.")
 
 	bifh.BindBoolFlag("json", false, "Output bridge account as JSON.")
-	bifh.BindBoolFlag("async", false, "If true, the function will return immediately. If false, the function will wait for the transaction to be seen on the network.")
+	bindWaitFlags(bifh)
+	bindNonceManagerFlag(bifh)
+	bifh.BindBoolFlag("dry-run", false, dryRunFlagDescription)
 
 	bifh.BindStringPFlag("sequencer-url", "u", DefaultSequencerURL, "The URL of the sequencer to init bridge account on.")
 
@@ -204,7 +460,9 @@ func init() {
 	blfh.BindStringFlag("fee-asset", DefaultFeeAsset, "The asset used to pay the transaction fee.")
 
 	blfh.BindBoolFlag("json", false, "Output bridge account as JSON")
-	blfh.BindBoolFlag("async", false, "If true, the function will return immediately. If false, the function will wait for the transaction to be seen on the network.")
+	bindWaitFlags(blfh)
+	bindNonceManagerFlag(blfh)
+	blfh.BindBoolFlag("dry-run", false, dryRunFlagDescription)
 	blfh.BindStringPFlag("sequencer-url", "u", DefaultSequencerURL, "The URL of the sequencer to lock assets on.")
 
 	blfh.BindStringFlag("keyfile", "", "Path to secure keyfile for the bridge account.")
@@ -212,4 +470,56 @@ func init() {
 	blfh.BindStringFlag("privkey", "", "The private key of the bridge account.")
 	bridgeLockCmd.MarkFlagsOneRequired("keyfile", "keyring-address", "privkey")
 	bridgeLockCmd.MarkFlagsMutuallyExclusive("keyfile", "keyring-address", "privkey")
+
+	bridgeCmd.AddCommand(bridgeUnlockCmd)
+	bufh := cmd.CreateCliFlagHandler(bridgeUnlockCmd, cmd.EnvPrefix)
+	bufh.BindStringFlag("network", cmd.DefaultTargetNetwork, "Configure the values to target a specific network.")
+	bufh.BindStringFlag("sequencer-chain-id", DefaultSequencerChainID, "The chain ID of the sequencer.")
+	bufh.BindStringFlag("fee-asset", DefaultFeeAsset, "The asset used to pay the transaction fee.")
+	bufh.BindStringFlag("bridge-address", "", "The address of the bridge account to unlock tokens from.")
+	bufh.BindStringFlag("rollup-block-number", "", "The block number on the rollup that triggered the withdrawal.")
+	bufh.BindStringFlag("rollup-withdrawal-event-id", "", "The identifier of the rollup event that triggered the withdrawal, such as a transaction hash.")
+	bufh.BindStringFlag("memo", "", "Optional memo to include with the unlock.")
+
+	bufh.BindBoolFlag("json", false, "Output bridge unlock as JSON")
+	bindWaitFlags(bufh)
+	bindNonceManagerFlag(bufh)
+	bufh.BindStringPFlag("sequencer-url", "u", DefaultSequencerURL, "The URL of the sequencer to unlock assets on.")
+
+	bufh.BindStringFlag("keyfile", "", "Path to secure keyfile for the bridge withdrawer account.")
+	bufh.BindStringFlag("keyring-address", "", "The address of the bridge withdrawer account. Requires private key be stored in keyring.")
+	bufh.BindStringFlag("privkey", "", "The private key of the bridge withdrawer account.")
+	bridgeUnlockCmd.MarkFlagsOneRequired("keyfile", "keyring-address", "privkey")
+	bridgeUnlockCmd.MarkFlagsMutuallyExclusive("keyfile", "keyring-address", "privkey")
+	for _, f := range []string{"bridge-address", "rollup-block-number", "rollup-withdrawal-event-id"} {
+		if err := bridgeUnlockCmd.MarkFlagRequired(f); err != nil {
+			log.WithError(err).Fatalf("Error marking %s flag as required", f)
+		}
+	}
+
+	bridgeCmd.AddCommand(bridgeSudoChangeCmd)
+	bscfh := cmd.CreateCliFlagHandler(bridgeSudoChangeCmd, cmd.EnvPrefix)
+	bscfh.BindStringFlag("network", cmd.DefaultTargetNetwork, "Configure the values to target a specific network.")
+	bscfh.BindStringFlag("sequencer-chain-id", DefaultSequencerChainID, "The chain ID of the sequencer.")
+	bscfh.BindStringFlag("fee-asset", DefaultFeeAsset, "The asset used to pay the transaction fee.")
+	bscfh.BindStringFlag("new-sudo-address", "", "The new sudo address for the bridge account. Left unchanged if not set.")
+	bscfh.BindStringFlag("new-withdrawer-address", "", "The new withdrawer address for the bridge account. Left unchanged if not set.")
+
+	bscfh.BindBoolFlag("json", false, "Output bridge sudo change as JSON")
+	bindWaitFlags(bscfh)
+	bindNonceManagerFlag(bscfh)
+	bscfh.BindStringPFlag("sequencer-url", "u", DefaultSequencerURL, "The URL of the sequencer.")
+
+	bscfh.BindStringFlag("keyfile", "", "Path to secure keyfile for the bridge sudo account.")
+	bscfh.BindStringFlag("keyring-address", "", "The address of the bridge sudo account. Requires private key be stored in keyring.")
+	bscfh.BindStringFlag("privkey", "", "The private key of the bridge sudo account.")
+	bridgeSudoChangeCmd.MarkFlagsOneRequired("keyfile", "keyring-address", "privkey")
+	bridgeSudoChangeCmd.MarkFlagsMutuallyExclusive("keyfile", "keyring-address", "privkey")
+	bridgeSudoChangeCmd.MarkFlagsOneRequired("new-sudo-address", "new-withdrawer-address")
+
+	bridgeCmd.AddCommand(bridgeInfoCmd)
+	binfh := cmd.CreateCliFlagHandler(bridgeInfoCmd, cmd.EnvPrefix)
+	binfh.BindStringFlag("network", cmd.DefaultTargetNetwork, "Configure the values to target a specific network.")
+	binfh.BindStringPFlag("sequencer-url", "u", DefaultSequencerURL, "The URL of the sequencer to query.")
+	binfh.BindBoolFlag("json", false, "Output bridge info as JSON")
 }
@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 
 	"github.com/astriaorg/astria-cli-go/modules/cli/cmd"
+	"github.com/astriaorg/astria-cli-go/modules/cli/internal/asset"
 	log "github.com/sirupsen/logrus"
 
 	"github.com/pelletier/go-toml/v2"
@@ -18,6 +19,42 @@ type NetworkConfig struct {
 	SequencerURL     string `flag:"sequencer-url" mapstructure:"sequencer_url" toml:"sequencer_url"`
 	Asset            string `flag:"asset" mapstructure:"asset" toml:"asset"`
 	FeeAsset         string `flag:"fee-asset" mapstructure:"fee_asset" toml:"fee_asset"`
+	// Assets configures the display units of assets, keyed by denom
+	Assets map[string]AssetConfig `mapstructure:"assets" toml:"assets,omitempty"`
+}
+
+// AssetConfig is the struct that holds the display unit of an asset, used to
+// parse and show amounts in units like "TIA" rather than in base units.
+type AssetConfig struct {
+	// Display is the name of the display unit
+	Display string `mapstructure:"display" toml:"display"`
+	// Decimals is the number of decimal places of the display unit
+	Decimals uint32 `mapstructure:"decimals" toml:"decimals"`
+}
+
+// DefaultAssetConfigs are the display units of well known assets, keyed by
+// base denom. They are used for assets that are not configured in a network's
+// config.
+var DefaultAssetConfigs = map[string]AssetConfig{
+	"ntia": {Display: "TIA", Decimals: 9},
+	"utia": {Display: "TIA", Decimals: 6},
+}
+
+// AssetUnits returns the units of the asset with the given denom. The
+// network's config is checked for the denom and then for its base denom,
+// ie. "utia" for "transfer/channel-0/utia", before falling back to
+// DefaultAssetConfigs. The returned units have no display unit if none is
+// configured.
+func (nc NetworkConfig) AssetUnits(denom string) asset.Units {
+	base := asset.BaseDenom(denom)
+	c, ok := nc.Assets[denom]
+	if !ok {
+		c, ok = nc.Assets[base]
+	}
+	if !ok {
+		c = DefaultAssetConfigs[base]
+	}
+	return asset.Units{Denom: denom, Display: c.Display, Decimals: c.Decimals}
 }
 
 // NetworkConfigs is a map of NetworkConfig structs.
@@ -36,24 +73,36 @@ func GetSequencerNetworkConfigsPresets() NetworkConfigs {
 				SequencerURL:     "http://127.0.0.1:26657",
 				Asset:            "ntia",
 				FeeAsset:         "ntia",
+				Assets: map[string]AssetConfig{
+					"ntia": {Display: "TIA", Decimals: 9},
+				},
 			},
 			"dusk": {
 				SequencerChainId: "dusk-" + cmd.DefaultDuskNum,
 				SequencerURL:     "https://rpc.sequencer.dusk-" + cmd.DefaultDuskNum + ".devnet.astria.org",
 				Asset:            "ntia",
 				FeeAsset:         "ntia",
+				Assets: map[string]AssetConfig{
+					"ntia": {Display: "TIA", Decimals: 9},
+				},
 			},
 			"dawn": {
 				SequencerChainId: DefaultSequencerChainID,
 				SequencerURL:     DefaultSequencerURL,
 				Asset:            "ibc/channel-0/utia",
 				FeeAsset:         "ibc/channel-0/utia",
+				Assets: map[string]AssetConfig{
+					"ibc/channel-0/utia": {Display: "TIA", Decimals: 6},
+				},
 			},
 			"mainnet": {
 				SequencerChainId: "astria",
 				SequencerURL:     "https://rpc.astria.org",
 				Asset:            "ibc/channel-0/utia",
 				FeeAsset:         "ibc/channel-0/utia",
+				Assets: map[string]AssetConfig{
+					"ibc/channel-0/utia": {Display: "TIA", Decimals: 6},
+				},
 			},
 		},
 	}
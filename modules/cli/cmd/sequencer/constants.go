@@ -1,6 +1,8 @@
 package sequencer
 
 import (
+	"time"
+
 	"github.com/astriaorg/astria-cli-go/modules/cli/cmd"
 )
 
@@ -12,4 +14,9 @@ const (
 	DefaultAsset                           = "ntia"
 	DefaultFeeAsset                        = "ntia"
 	DefaultSequencerNetworksConfigFilename = "sequencer-networks-config.toml"
+	DefaultAssetCacheFilename              = "asset-cache.json"
 )
+
+// DefaultOfflineIbcTimeout is how long ICS20 withdrawals in transactions
+// created for offline signing stay valid by default.
+const DefaultOfflineIbcTimeout = 24 * time.Hour
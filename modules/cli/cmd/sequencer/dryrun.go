@@ -0,0 +1,30 @@
+package sequencer
+
+import (
+	"github.com/astriaorg/astria-cli-go/modules/cli/internal/sequencer"
+	"github.com/astriaorg/astria-cli-go/modules/cli/internal/ui"
+	log "github.com/sirupsen/logrus"
+)
+
+// dryRunFlagDescription is the usage of the --dry-run flag shared by the
+// transaction commands.
+const dryRunFlagDescription = "Build and sign the transaction and print its cost breakdown without broadcasting it."
+
+// renderDryRun runs a dry run of the transaction described by opts and prints
+// its cost breakdown.
+func renderDryRun(opts sequencer.DryRunOpts, printJSON bool) {
+	res, err := sequencer.DryRun(opts)
+	if err != nil {
+		log.WithError(err).Error("Error running dry run")
+		panic(err)
+	}
+	if !res.Sufficient {
+		log.Warn("Balance is insufficient to cover the transaction's cost")
+	}
+
+	printer := ui.ResultsPrinter{
+		Data:      res,
+		PrintJSON: printJSON,
+	}
+	printer.Render()
+}
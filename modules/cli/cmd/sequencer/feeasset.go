@@ -0,0 +1,130 @@
+package sequencer
+
+import (
+	"github.com/astriaorg/astria-cli-go/modules/cli/cmd"
+	"github.com/astriaorg/astria-cli-go/modules/cli/internal/sequencer"
+	"github.com/astriaorg/astria-cli-go/modules/cli/internal/ui"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var feeAssetCmd = &cobra.Command{
+	Use:   "fee-asset",
+	Short: "Add or remove an allowed fee asset.",
+}
+
+// addFeeAssetCmd represents the `sudo fee-asset add` command
+var addFeeAssetCmd = &cobra.Command{
+	Use:   "add [asset] [--keyfile | --keyring-address | --privkey]",
+	Short: "Add an asset to the list of allowed fee assets.",
+	Args:  cobra.ExactArgs(1),
+	Run:   addFeeAssetCmdHandler,
+}
+
+func addFeeAssetCmdHandler(c *cobra.Command, args []string) {
+	opts := feeAssetOptsFromFlags(c, args)
+	res, err := sequencer.AddFeeAsset(opts)
+	if err != nil {
+		log.WithError(err).Error("Error adding fee asset")
+		panic(err)
+	}
+
+	flagHandler := cmd.CreateCliFlagHandler(c, cmd.EnvPrefix)
+	printJSON := flagHandler.GetValue("json") == "true"
+	printer := ui.ResultsPrinter{
+		Data:      res,
+		PrintJSON: printJSON,
+	}
+	printer.Render()
+}
+
+// removeFeeAssetCmd represents the `sudo fee-asset remove` command
+var removeFeeAssetCmd = &cobra.Command{
+	Use:   "remove [asset] [--keyfile | --keyring-address | --privkey]",
+	Short: "Remove an asset from the list of allowed fee assets.",
+	Args:  cobra.ExactArgs(1),
+	Run:   removeFeeAssetCmdHandler,
+}
+
+func removeFeeAssetCmdHandler(c *cobra.Command, args []string) {
+	opts := feeAssetOptsFromFlags(c, args)
+	res, err := sequencer.RemoveFeeAsset(opts)
+	if err != nil {
+		log.WithError(err).Error("Error removing fee asset")
+		panic(err)
+	}
+
+	flagHandler := cmd.CreateCliFlagHandler(c, cmd.EnvPrefix)
+	printJSON := flagHandler.GetValue("json") == "true"
+	printer := ui.ResultsPrinter{
+		Data:      res,
+		PrintJSON: printJSON,
+	}
+	printer.Render()
+}
+
+// feeAssetOptsFromFlags builds the FeeAssetOpts shared by the fee asset add
+// and remove commands.
+func feeAssetOptsFromFlags(c *cobra.Command, args []string) sequencer.FeeAssetOpts {
+	flagHandler := cmd.CreateCliFlagHandlerWithUseConfigFlag(c, cmd.EnvPrefix, "network")
+	networkConfig := GetNetworkConfigFromFlags(flagHandler)
+	flagHandler.SetConfig(networkConfig)
+
+	sequencerURL := flagHandler.GetValue("sequencer-url")
+	sequencerURL = AddPortToURL(sequencerURL)
+	sequencerChainID := flagHandler.GetValue("sequencer-chain-id")
+	wait := waitOptionsFromFlags(flagHandler)
+
+	priv, err := GetPrivateKeyFromFlags(c)
+	if err != nil {
+		log.WithError(err).Error("Could not get private key from flags")
+		panic(err)
+	}
+	from, err := PrivateKeyFromText(priv)
+	if err != nil {
+		log.WithError(err).Error("Error decoding private key")
+		panic(err)
+	}
+
+	return sequencer.FeeAssetOpts{
+		Wait:             wait,
+		UseNonceManager:  flagHandler.GetValue("nonce-manager") == "true",
+		AddressPrefix:    DefaultAddressPrefix,
+		FromKey:          from,
+		SequencerURL:     sequencerURL,
+		SequencerChainID: sequencerChainID,
+		Asset:            args[0],
+	}
+}
+
+func init() {
+	sudoCmd.AddCommand(feeAssetCmd)
+
+	feeAssetCmd.AddCommand(addFeeAssetCmd)
+	afafh := cmd.CreateCliFlagHandler(addFeeAssetCmd, cmd.EnvPrefix)
+	afafh.BindStringFlag("network", cmd.DefaultTargetNetwork, "Configure the values to target a specific network.")
+	afafh.BindStringPFlag("sequencer-url", "u", DefaultSequencerURL, "The URL of the sequencer.")
+	afafh.BindStringPFlag("sequencer-chain-id", "c", DefaultSequencerChainID, "The chain ID of the sequencer.")
+	afafh.BindBoolFlag("json", false, "Output in JSON format.")
+	bindWaitFlags(afafh)
+	bindNonceManagerFlag(afafh)
+	afafh.BindStringFlag("keyfile", "", "Path to secure keyfile for the sudo account.")
+	afafh.BindStringFlag("keyring-address", "", "The address of the sudo account. Requires private key be stored in keyring.")
+	afafh.BindStringFlag("privkey", "", "The private key of the sudo account.")
+	addFeeAssetCmd.MarkFlagsOneRequired("keyfile", "keyring-address", "privkey")
+	addFeeAssetCmd.MarkFlagsMutuallyExclusive("keyfile", "keyring-address", "privkey")
+
+	feeAssetCmd.AddCommand(removeFeeAssetCmd)
+	rfafh := cmd.CreateCliFlagHandler(removeFeeAssetCmd, cmd.EnvPrefix)
+	rfafh.BindStringFlag("network", cmd.DefaultTargetNetwork, "Configure the values to target a specific network.")
+	rfafh.BindStringPFlag("sequencer-url", "u", DefaultSequencerURL, "The URL of the sequencer.")
+	rfafh.BindStringPFlag("sequencer-chain-id", "c", DefaultSequencerChainID, "The chain ID of the sequencer.")
+	rfafh.BindBoolFlag("json", false, "Output in JSON format.")
+	bindWaitFlags(rfafh)
+	bindNonceManagerFlag(rfafh)
+	rfafh.BindStringFlag("keyfile", "", "Path to secure keyfile for the sudo account.")
+	rfafh.BindStringFlag("keyring-address", "", "The address of the sudo account. Requires private key be stored in keyring.")
+	rfafh.BindStringFlag("privkey", "", "The private key of the sudo account.")
+	removeFeeAssetCmd.MarkFlagsOneRequired("keyfile", "keyring-address", "privkey")
+	removeFeeAssetCmd.MarkFlagsMutuallyExclusive("keyfile", "keyring-address", "privkey")
+}
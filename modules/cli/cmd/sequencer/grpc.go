@@ -0,0 +1,117 @@
+package sequencer
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/astriaorg/astria-cli-go/modules/cli/cmd"
+	"github.com/astriaorg/astria-cli-go/modules/cli/cmd/devrunner/config"
+	"github.com/astriaorg/astria-cli-go/modules/cli/internal/sequencer"
+	"github.com/astriaorg/astria-cli-go/modules/cli/internal/ui"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+// grpcCmd represents the grpc command
+var grpcCmd = &cobra.Command{
+	Use:   "grpc",
+	Short: "Query the sequencer's gRPC SequencerService.",
+}
+
+// grpcBlockCmd represents the `grpc block` command
+var grpcBlockCmd = &cobra.Command{
+	Use:   "block [height] [--rollup [name|id]]",
+	Short: "Get the sequencer block consumed by conductor at a height.",
+	Long: `Get a sequencer block from the sequencer's gRPC endpoint, as consumed by
+conductor. The table lists the IDs of the block's rollups, the transactions of
+each rollup and the Merkle proofs of the rollup transactions and rollup IDs.
+
+--rollup filters the block to the transactions of the given rollups, which
+are given by name or as 0x prefixed hex IDs, separated by commas. The IDs of
+all rollups in the block are still listed.
+
+The gRPC URL is read from the devrunner's networks-config.toml of --instance,
+for the network given by --network, unless --sequencer-grpc-url is set.`,
+	Args: cobra.ExactArgs(1),
+	Run:  grpcBlockCmdHandler,
+}
+
+func grpcBlockCmdHandler(c *cobra.Command, args []string) {
+	flagHandler := cmd.CreateCliFlagHandler(c, cmd.EnvPrefix)
+
+	printJSON := flagHandler.GetValue("json") == "true"
+
+	height, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		log.WithError(err).Error("Error parsing height to uint64")
+		panic(err)
+	}
+
+	grpcURL := flagHandler.GetValue("sequencer-grpc-url")
+	if grpcURL == "" {
+		grpcURL = sequencerGRPCURL(flagHandler.GetValue("instance"), flagHandler.GetValue("network"))
+	}
+
+	var rollups []string
+	for _, rollup := range strings.Split(flagHandler.GetValue("rollup"), ",") {
+		if rollup = strings.TrimSpace(rollup); rollup != "" {
+			rollups = append(rollups, rollup)
+		}
+	}
+
+	opts := sequencer.SequencerBlockOpts{
+		SequencerGRPCURL: grpcURL,
+		Height:           height,
+		Rollups:          rollups,
+	}
+	block, err := sequencer.GetSequencerBlock(opts)
+	if err != nil {
+		log.WithError(err).Error("Error getting sequencer block")
+		panic(err)
+	}
+
+	printer := ui.ResultsPrinter{
+		Data:      block,
+		PrintJSON: printJSON,
+	}
+	printer.Render()
+}
+
+// sequencerGRPCURL returns the sequencer gRPC URL of a network from the
+// devrunner's networks config of the given instance. The devrunner's default
+// networks are used if the instance has not been initialized.
+//
+// Panics if the network is not configured.
+func sequencerGRPCURL(instance, network string) string {
+	config.IsInstanceNameValidOrPanic(instance)
+
+	networksConfigPath := filepath.Join(cmd.GetUserHomeDirOrPanic(), DefaultConfigDirName, instance, config.DefaultNetworksConfigName)
+	var networkConfigs config.NetworkConfigs
+	if _, err := os.Stat(networksConfigPath); err == nil {
+		networkConfigs = config.LoadNetworkConfigsOrPanic(networksConfigPath)
+	} else {
+		log.Debugf("Networks config not found at %s, using the default networks", networksConfigPath)
+		networkConfigs = config.NewNetworksConfigs("", "", "", "")
+	}
+
+	networkConfig, ok := networkConfigs.Configs[network]
+	if !ok || networkConfig.SequencerGRPC == "" {
+		log.Errorf("No sequencer gRPC URL configured for network: %s", network)
+		panic("Network not found in networks config")
+	}
+	return networkConfig.SequencerGRPC
+}
+
+func init() {
+	SequencerCmd.AddCommand(grpcCmd)
+
+	grpcCmd.AddCommand(grpcBlockCmd)
+	flagHandler := cmd.CreateCliFlagHandler(grpcBlockCmd, cmd.EnvPrefix)
+	flagHandler.BindStringFlag("network", cmd.DefaultTargetNetwork, "The devrunner network to read the sequencer gRPC URL from.")
+	flagHandler.BindStringFlag("instance", config.DefaultInstanceName, "The devrunner instance to read the networks config from.")
+	flagHandler.BindStringFlag("sequencer-grpc-url", "", "The URL of the sequencer's gRPC endpoint. Overrides the URL from the networks config.")
+	flagHandler.BindStringFlag("rollup", "", "Comma separated names or 0x prefixed IDs of the rollups to filter the block to.")
+	flagHandler.BindBoolFlag("json", false, "Output the sequencer block in JSON format.")
+}
@@ -2,14 +2,19 @@ package sequencer
 
 import (
 	"crypto/ed25519"
+	"encoding/base64"
 	"encoding/hex"
 	"fmt"
+	"io"
 	"math/big"
+	"os"
 	"regexp"
 	"strings"
 
 	primproto "buf.build/gen/go/astria/primitives/protocolbuffers/go/astria/primitive/v1"
+	"github.com/astriaorg/astria-cli-go/modules/cli/internal/asset"
 	"github.com/astriaorg/astria-cli-go/modules/cli/internal/keys"
+	"github.com/astriaorg/astria-cli-go/modules/go-sequencer-client/client"
 	"github.com/pterm/pterm"
 	"github.com/spf13/cobra"
 
@@ -69,24 +74,54 @@ func convertToUint128(numStr string) (*primproto.Uint128, error) {
 		return nil, fmt.Errorf("failed to convert string to big.Int")
 	}
 
-	// check if the number is negative or overflows Uint128
-	if bigInt.Sign() < 0 {
-		return nil, fmt.Errorf("negative number not allowed")
-	} else if bigInt.BitLen() > 128 {
-		return nil, fmt.Errorf("value overflows Uint128")
-	}
+	return client.BigIntToProtoU128(bigInt)
+}
 
-	// split the big.Int into two uint64s
-	// convert the big.Int to uint64, which will drop the higher 64 bits
-	lo := bigInt.Uint64()
-	// shift the big.Int to the right by 64 bits and convert to uint64
-	hi := bigInt.Rsh(bigInt, 64).Uint64()
-	uint128 := &primproto.Uint128{
-		Lo: lo,
-		Hi: hi,
+// amountFromText parses an amount of the asset described by units, given in
+// base units or in display units, ie. "1500000", "1500000utia" or "1.5TIA",
+// and converts it to an Uint128 protobuf of base units.
+func amountFromText(text string, units asset.Units) (*primproto.Uint128, error) {
+	amount, err := asset.ParseAmount(text, units)
+	if err != nil {
+		return nil, err
 	}
+	return convertToUint128(amount.String())
+}
 
-	return uint128, nil
+// DataFromText decodes rollup data from its text representation. The input
+// may be the data itself, "@<path>" to read the data from a file, or "-" to
+// read the data from the given reader (usually stdin). The data is then decoded
+// according to the encoding, which is one of "hex", "base64", or "raw". Raw
+// data is used as-is; hex and base64 data may be surrounded by whitespace.
+func DataFromText(input string, encoding string, stdin io.Reader) ([]byte, error) {
+	var text []byte
+	switch {
+	case input == "-":
+		b, err := io.ReadAll(stdin)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read data from stdin: %w", err)
+		}
+		text = b
+	case strings.HasPrefix(input, "@"):
+		b, err := os.ReadFile(strings.TrimPrefix(input, "@"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read data from file: %w", err)
+		}
+		text = b
+	default:
+		text = []byte(input)
+	}
+
+	switch encoding {
+	case "raw":
+		return text, nil
+	case "hex":
+		return hex.DecodeString(strip0xPrefix(strings.TrimSpace(string(text))))
+	case "base64":
+		return base64.StdEncoding.DecodeString(strings.TrimSpace(string(text)))
+	default:
+		return nil, fmt.Errorf("unsupported data encoding: %s", encoding)
+	}
 }
 
 // strip0xPrefix removes the 0x prefix from a string if present.
@@ -3,6 +3,9 @@ package sequencer
 import (
 	"crypto/ed25519"
 	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -102,3 +105,82 @@ func TestStrip0xPrefix(t *testing.T) {
 		assert.Equal(t, want, got)
 	})
 }
+
+func TestDataFromText(t *testing.T) {
+	dir := t.TempDir()
+	hexFile := filepath.Join(dir, "data.hex")
+	err := os.WriteFile(hexFile, []byte("0xdeadbeef\n"), 0644)
+	assert.NoError(t, err)
+	rawFile := filepath.Join(dir, "data.bin")
+	err = os.WriteFile(rawFile, []byte{0x00, 0x01, 0xff}, 0644)
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		name        string
+		input       string
+		encoding    string
+		stdin       string
+		expected    []byte
+		expectError bool
+	}{
+		{"Hex", "deadbeef", "hex", "", []byte{0xde, 0xad, 0xbe, 0xef}, false},
+		{"HexWith0x", "0xdeadbeef", "hex", "", []byte{0xde, 0xad, 0xbe, 0xef}, false},
+		{"InvalidHex", "nothex", "hex", "", nil, true},
+		{"Base64", "3q2+7w==", "base64", "", []byte{0xde, 0xad, 0xbe, 0xef}, false},
+		{"InvalidBase64", "!!!", "base64", "", nil, true},
+		{"Raw", "hello", "raw", "", []byte("hello"), false},
+		{"HexFile", "@" + hexFile, "hex", "", []byte{0xde, 0xad, 0xbe, 0xef}, false},
+		{"RawFile", "@" + rawFile, "raw", "", []byte{0x00, 0x01, 0xff}, false},
+		{"MissingFile", "@" + filepath.Join(dir, "missing"), "raw", "", nil, true},
+		{"Stdin", "-", "base64", "3q2+7w==\n", []byte{0xde, 0xad, 0xbe, 0xef}, false},
+		{"UnknownEncoding", "deadbeef", "binary", "", nil, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := DataFromText(tc.input, tc.encoding, strings.NewReader(tc.stdin))
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, data)
+		})
+	}
+}
+
+func TestAmountFromText(t *testing.T) {
+	nc := NetworkConfig{
+		Assets: map[string]AssetConfig{
+			"ibc/channel-0/utia": {Display: "TIA", Decimals: 6},
+		},
+	}
+	testCases := []struct {
+		name        string
+		input       string
+		denom       string
+		expectedLo  uint64
+		expectError bool
+	}{
+		{"BaseUnits", "1500000", "ibc/channel-0/utia", 1500000, false},
+		{"BaseDenom", "1500000utia", "ibc/channel-0/utia", 1500000, false},
+		{"DisplayUnit", "1.5TIA", "ibc/channel-0/utia", 1500000, false},
+		{"DefaultDisplayUnit", "1.5TIA", "ntia", 1500000000, false},
+		{"TooPrecise", "1.0000001TIA", "ibc/channel-0/utia", 0, true},
+		{"FractionalBaseUnits", "1.5utia", "ibc/channel-0/utia", 0, true},
+		{"OtherAsset", "1.5TIA", "nria", 0, true},
+		{"Overflow", "340282366920938463463374607431768211456", "nria", 0, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			amount, err := amountFromText(tc.input, nc.AssetUnits(tc.denom))
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedLo, amount.Lo)
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package sequencer
+
+import (
+	"strconv"
+
+	"github.com/astriaorg/astria-cli-go/modules/bech32m"
+	"github.com/astriaorg/astria-cli-go/modules/cli/cmd"
+	"github.com/astriaorg/astria-cli-go/modules/cli/internal/sequencer"
+	"github.com/astriaorg/astria-cli-go/modules/cli/internal/ui"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+// historyCmd represents the history command
+var historyCmd = &cobra.Command{
+	Use:   "history [address] --from-height [height] [--to-height [height]]",
+	Short: "List the actions involving an address over a range of blocks.",
+	Long: `Scan every block between --from-height and --to-height (inclusive) and list
+each action involving the address. All actions of transactions signed by the
+address are listed, along with any other action that references it, ie. as
+the recipient of a transfer, a bridge account or a sudo address. The Roles
+column shows how the address is involved.
+
+--to-height defaults to the latest block.`,
+	Args: cobra.ExactArgs(1),
+	Run:  historyCmdHandler,
+}
+
+func historyCmdHandler(c *cobra.Command, args []string) {
+	flagHandler := cmd.CreateCliFlagHandlerWithUseConfigFlag(c, cmd.EnvPrefix, "network")
+	networkConfig := GetNetworkConfigFromFlags(flagHandler)
+	flagHandler.SetConfig(networkConfig)
+
+	printJSON := flagHandler.GetValue("json") == "true"
+	printCSV := flagHandler.GetValue("csv") == "true"
+	sequencerURL := flagHandler.GetValue("sequencer-url")
+	sequencerURL = AddPortToURL(sequencerURL)
+
+	address := args[0]
+	if err := bech32m.Validate(address); err != nil {
+		log.WithError(err).Errorf("Invalid address: %s", address)
+		panic(err)
+	}
+
+	fromHeight, err := strconv.ParseInt(flagHandler.GetValue("from-height"), 10, 64)
+	if err != nil {
+		log.WithError(err).Error("Error parsing from-height to int64")
+		panic(err)
+	}
+	toHeight := int64(0)
+	if to := flagHandler.GetValue("to-height"); to != "" {
+		toHeight, err = strconv.ParseInt(to, 10, 64)
+		if err != nil {
+			log.WithError(err).Error("Error parsing to-height to int64")
+			panic(err)
+		}
+	}
+	concurrency, err := strconv.Atoi(flagHandler.GetValue("concurrency"))
+	if err != nil {
+		log.WithError(err).Error("Error parsing concurrency to int")
+		panic(err)
+	}
+
+	opts := sequencer.HistoryOpts{
+		AddressPrefix: DefaultAddressPrefix,
+		SequencerURL:  sequencerURL,
+		Address:       address,
+		FromHeight:    fromHeight,
+		ToHeight:      toHeight,
+		Concurrency:   concurrency,
+	}
+	history, err := sequencer.GetHistory(opts)
+	if err != nil {
+		log.WithError(err).Error("Error getting history")
+		panic(err)
+	}
+
+	printer := ui.ResultsPrinter{
+		Data:      history,
+		PrintJSON: printJSON,
+		PrintCSV:  printCSV,
+	}
+	printer.Render()
+}
+
+func init() {
+	SequencerCmd.AddCommand(historyCmd)
+
+	flagHandler := cmd.CreateCliFlagHandler(historyCmd, cmd.EnvPrefix)
+	flagHandler.BindStringFlag("network", cmd.DefaultTargetNetwork, "Configure the values to target a specific network.")
+	flagHandler.BindStringPFlag("sequencer-url", "u", DefaultSequencerURL, "The URL of the sequencer to retrieve the blocks from.")
+	flagHandler.BindStringFlag("from-height", "", "The first block height to scan.")
+	flagHandler.BindStringFlag("to-height", "", "The last block height to scan. Defaults to the latest block.")
+	flagHandler.BindStringFlag("concurrency", strconv.Itoa(sequencer.DefaultHistoryConcurrency), "The number of blocks to fetch at once.")
+	flagHandler.BindBoolFlag("json", false, "Output the history in JSON format.")
+	flagHandler.BindBoolFlag("csv", false, "Output the history in CSV format.")
+	historyCmd.MarkFlagsMutuallyExclusive("json", "csv")
+	if err := historyCmd.MarkFlagRequired("from-height"); err != nil {
+		log.WithError(err).Fatal("Error marking from-height flag as required")
+	}
+}
@@ -0,0 +1,138 @@
+package sequencer
+
+import (
+	"github.com/astriaorg/astria-cli-go/modules/bech32m"
+	"github.com/astriaorg/astria-cli-go/modules/cli/cmd"
+	"github.com/astriaorg/astria-cli-go/modules/cli/internal/sequencer"
+	"github.com/astriaorg/astria-cli-go/modules/cli/internal/ui"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var ibcRelayerCmd = &cobra.Command{
+	Use:   "ibc-relayer",
+	Short: "Add or remove an IBC relayer address.",
+}
+
+// addIBCRelayerCmd represents the `sudo ibc-relayer add` command
+var addIBCRelayerCmd = &cobra.Command{
+	Use:   "add [address] [--keyfile | --keyring-address | --privkey]",
+	Short: "Add an address to the list of allowed IBC relayers.",
+	Args:  cobra.ExactArgs(1),
+	Run:   addIBCRelayerCmdHandler,
+}
+
+func addIBCRelayerCmdHandler(c *cobra.Command, args []string) {
+	opts := ibcRelayerOptsFromFlags(c, args)
+	res, err := sequencer.AddIBCRelayer(opts)
+	if err != nil {
+		log.WithError(err).Error("Error adding IBC relayer")
+		panic(err)
+	}
+
+	flagHandler := cmd.CreateCliFlagHandler(c, cmd.EnvPrefix)
+	printJSON := flagHandler.GetValue("json") == "true"
+	printer := ui.ResultsPrinter{
+		Data:      res,
+		PrintJSON: printJSON,
+	}
+	printer.Render()
+}
+
+// removeIBCRelayerCmd represents the `sudo ibc-relayer remove` command
+var removeIBCRelayerCmd = &cobra.Command{
+	Use:   "remove [address] [--keyfile | --keyring-address | --privkey]",
+	Short: "Remove an address from the list of allowed IBC relayers.",
+	Args:  cobra.ExactArgs(1),
+	Run:   removeIBCRelayerCmdHandler,
+}
+
+func removeIBCRelayerCmdHandler(c *cobra.Command, args []string) {
+	opts := ibcRelayerOptsFromFlags(c, args)
+	res, err := sequencer.RemoveIBCRelayer(opts)
+	if err != nil {
+		log.WithError(err).Error("Error removing IBC relayer")
+		panic(err)
+	}
+
+	flagHandler := cmd.CreateCliFlagHandler(c, cmd.EnvPrefix)
+	printJSON := flagHandler.GetValue("json") == "true"
+	printer := ui.ResultsPrinter{
+		Data:      res,
+		PrintJSON: printJSON,
+	}
+	printer.Render()
+}
+
+// ibcRelayerOptsFromFlags builds the IBCRelayerOpts shared by the IBC relayer
+// add and remove commands. It panics if the relayer address is not a valid
+// bech32m address.
+func ibcRelayerOptsFromFlags(c *cobra.Command, args []string) sequencer.IBCRelayerOpts {
+	flagHandler := cmd.CreateCliFlagHandlerWithUseConfigFlag(c, cmd.EnvPrefix, "network")
+	networkConfig := GetNetworkConfigFromFlags(flagHandler)
+	flagHandler.SetConfig(networkConfig)
+
+	sequencerURL := flagHandler.GetValue("sequencer-url")
+	sequencerURL = AddPortToURL(sequencerURL)
+	sequencerChainID := flagHandler.GetValue("sequencer-chain-id")
+	wait := waitOptionsFromFlags(flagHandler)
+
+	priv, err := GetPrivateKeyFromFlags(c)
+	if err != nil {
+		log.WithError(err).Error("Could not get private key from flags")
+		panic(err)
+	}
+	from, err := PrivateKeyFromText(priv)
+	if err != nil {
+		log.WithError(err).Error("Error decoding private key")
+		panic(err)
+	}
+
+	relayer := args[0]
+	if err := bech32m.Validate(relayer); err != nil {
+		log.WithError(err).Errorf("Invalid IBC relayer address: %s", relayer)
+		panic(err)
+	}
+
+	return sequencer.IBCRelayerOpts{
+		Wait:              wait,
+		UseNonceManager:   flagHandler.GetValue("nonce-manager") == "true",
+		AddressPrefix:     DefaultAddressPrefix,
+		FromKey:           from,
+		SequencerURL:      sequencerURL,
+		SequencerChainID:  sequencerChainID,
+		IBCRelayerAddress: AddressFromText(relayer),
+	}
+}
+
+func init() {
+	sudoCmd.AddCommand(ibcRelayerCmd)
+
+	ibcRelayerCmd.AddCommand(addIBCRelayerCmd)
+	airfh := cmd.CreateCliFlagHandler(addIBCRelayerCmd, cmd.EnvPrefix)
+	airfh.BindStringFlag("network", cmd.DefaultTargetNetwork, "Configure the values to target a specific network.")
+	airfh.BindStringPFlag("sequencer-url", "u", DefaultSequencerURL, "The URL of the sequencer.")
+	airfh.BindStringPFlag("sequencer-chain-id", "c", DefaultSequencerChainID, "The chain ID of the sequencer.")
+	airfh.BindBoolFlag("json", false, "Output in JSON format.")
+	bindWaitFlags(airfh)
+	bindNonceManagerFlag(airfh)
+	airfh.BindStringFlag("keyfile", "", "Path to secure keyfile for the IBC sudo account.")
+	airfh.BindStringFlag("keyring-address", "", "The address of the IBC sudo account. Requires private key be stored in keyring.")
+	airfh.BindStringFlag("privkey", "", "The private key of the IBC sudo account.")
+	addIBCRelayerCmd.MarkFlagsOneRequired("keyfile", "keyring-address", "privkey")
+	addIBCRelayerCmd.MarkFlagsMutuallyExclusive("keyfile", "keyring-address", "privkey")
+
+	ibcRelayerCmd.AddCommand(removeIBCRelayerCmd)
+	rirfh := cmd.CreateCliFlagHandler(removeIBCRelayerCmd, cmd.EnvPrefix)
+	rirfh.BindStringFlag("network", cmd.DefaultTargetNetwork, "Configure the values to target a specific network.")
+	rirfh.BindStringPFlag("sequencer-url", "u", DefaultSequencerURL, "The URL of the sequencer.")
+	rirfh.BindStringPFlag("sequencer-chain-id", "c", DefaultSequencerChainID, "The chain ID of the sequencer.")
+	rirfh.BindBoolFlag("json", false, "Output in JSON format.")
+	bindWaitFlags(rirfh)
+	bindNonceManagerFlag(rirfh)
+	rirfh.BindStringFlag("keyfile", "", "Path to secure keyfile for the IBC sudo account.")
+	rirfh.BindStringFlag("keyring-address", "", "The address of the IBC sudo account. Requires private key be stored in keyring.")
+	rirfh.BindStringFlag("privkey", "", "The private key of the IBC sudo account.")
+	removeIBCRelayerCmd.MarkFlagsOneRequired("keyfile", "keyring-address", "privkey")
+	removeIBCRelayerCmd.MarkFlagsMutuallyExclusive("keyfile", "keyring-address", "privkey")
+}
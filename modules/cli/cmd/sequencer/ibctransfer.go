@@ -1,6 +1,7 @@
 package sequencer
 
 import (
+	txproto "buf.build/gen/go/astria/protocol-apis/protocolbuffers/go/astria/protocol/transaction/v1"
 	"github.com/astriaorg/astria-cli-go/modules/cli/cmd"
 	"github.com/astriaorg/astria-cli-go/modules/cli/internal/sequencer"
 	"github.com/astriaorg/astria-cli-go/modules/cli/internal/ui"
@@ -11,8 +12,14 @@ import (
 var ibctransferCmd = &cobra.Command{
 	Use:   "ibctransfer [amount] [to] [src-channel] [--keyfile | --keyring-address | --privkey]",
 	Short: "Ibc Transfer tokens from a sequencer account to another chain account.",
-	Args:  cobra.ExactArgs(3),
-	Run:   ibctransferCmdHandler,
+	Long: `Ibc Transfer tokens from a sequencer account to another chain account.
+
+The amount is in base units of the asset, ie. "1500000" or "1500000utia", or
+in its display unit, ie. "1.5TIA", if one is configured for the asset in the
+network config. Amounts with more decimal places than the unit allows are
+rejected.`,
+	Args: cobra.ExactArgs(3),
+	Run:  ibctransferCmdHandler,
 }
 
 func init() {
@@ -28,7 +35,9 @@ func init() {
 	flagHandler.BindStringFlag("asset", DefaultAsset, "The asset to be transferred.")
 	flagHandler.BindStringFlag("fee-asset", DefaultFeeAsset, "The asset used for paying fees.")
 	flagHandler.BindStringFlag("network", cmd.DefaultTargetNetwork, "Configure the values to target a specific network.")
-	flagHandler.BindBoolFlag("async", false, "If true, the function will return immediately. If false, the function will wait for the transaction to be seen on the network.")
+	bindWaitFlags(flagHandler)
+	bindNonceManagerFlag(flagHandler)
+	flagHandler.BindBoolFlag("dry-run", false, dryRunFlagDescription)
 
 	ibctransferCmd.MarkFlagsOneRequired("keyfile", "keyring-address", "privkey")
 	ibctransferCmd.MarkFlagsMutuallyExclusive("keyfile", "keyring-address", "privkey")
@@ -60,16 +69,17 @@ func ibctransferCmdHandler(c *cobra.Command, args []string) {
 		log.WithError(err).Error("Error decoding private key")
 		panic(err)
 	}
-	amount, err := convertToUint128(args[0])
+	amount, err := amountFromText(args[0], networkConfig.AssetUnits(asset))
 	if err != nil {
-		log.WithError(err).Error("Error converting amount to Uint128 proto")
+		log.WithError(err).Error("Error parsing amount")
 		panic(err)
 	}
 
-	isAsync := flagHandler.GetValue("async") == "true"
+	wait := waitOptionsFromFlags(flagHandler)
 
 	opts := sequencer.IbcTransferOpts{
-		IsAsync:                        isAsync,
+		Wait:                           wait,
+		UseNonceManager:                flagHandler.GetValue("nonce-manager") == "true",
 		AddressPrefix:                  DefaultAddressPrefix,
 		SequencerURL:                   sequencerURL,
 		FromKey:                        from,
@@ -81,6 +91,17 @@ func ibctransferCmdHandler(c *cobra.Command, args []string) {
 		SequencerChainID:               sequencerChainID,
 		SourceChannelID:                sourceChannelID,
 	}
+	if flagHandler.GetValue("dry-run") == "true" {
+		renderDryRun(sequencer.DryRunOpts{
+			AddressPrefix:    DefaultAddressPrefix,
+			SequencerURL:     sequencerURL,
+			FromKey:          from,
+			SequencerChainID: sequencerChainID,
+			Actions:          []*txproto.Action{sequencer.IbcTransferAction(opts)},
+		}, printJSON)
+		return
+	}
+
 	tx, err := sequencer.IbcTransfer(opts)
 	if err != nil {
 		log.WithError(err).Error("Error transferring tokens")
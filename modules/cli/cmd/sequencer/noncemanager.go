@@ -0,0 +1,11 @@
+package sequencer
+
+import (
+	"github.com/astriaorg/astria-cli-go/modules/cli/cmd"
+)
+
+// bindNonceManagerFlag binds the flag that reserves a transaction command's
+// nonce with the local nonce manager instead of using the sequencer's nonce.
+func bindNonceManagerFlag(flagHandler *cmd.CliFlagHandler) {
+	flagHandler.BindBoolFlag("nonce-manager", false, "Reserve the transaction's nonce with the local nonce manager, so that concurrent astria-go processes signing with the same key use distinct nonces.")
+}
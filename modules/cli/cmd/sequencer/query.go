@@ -0,0 +1,251 @@
+package sequencer
+
+import (
+	"github.com/astriaorg/astria-cli-go/modules/bech32m"
+	"github.com/astriaorg/astria-cli-go/modules/cli/cmd"
+	"github.com/astriaorg/astria-cli-go/modules/cli/internal/sequencer"
+	"github.com/astriaorg/astria-cli-go/modules/cli/internal/ui"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+// queryCmd represents the query command
+var queryCmd = &cobra.Command{
+	Use:   "query",
+	Short: "Query the sequencer's application state.",
+}
+
+// queryDenomCmd represents the `query denom` command
+var queryDenomCmd = &cobra.Command{
+	Use:   "denom [asset-id]",
+	Short: "Get the denom of an asset from its hex encoded ID.",
+	Args:  cobra.ExactArgs(1),
+	Run:   queryDenomCmdHandler,
+}
+
+func queryDenomCmdHandler(c *cobra.Command, args []string) {
+	flagHandler := cmd.CreateCliFlagHandlerWithUseConfigFlag(c, cmd.EnvPrefix, "network")
+	networkConfig := GetNetworkConfigFromFlags(flagHandler)
+	flagHandler.SetConfig(networkConfig)
+
+	printJSON := flagHandler.GetValue("json") == "true"
+	sequencerURL := flagHandler.GetValue("sequencer-url")
+	sequencerURL = AddPortToURL(sequencerURL)
+
+	denom, err := sequencer.GetDenom(args[0], sequencerURL)
+	if err != nil {
+		log.WithError(err).Error("Error getting denom")
+		panic(err)
+	}
+
+	printer := ui.ResultsPrinter{
+		Data:      denom,
+		PrintJSON: printJSON,
+	}
+	printer.Render()
+}
+
+// queryFeeAssetsCmd represents the `query fee-assets` command
+var queryFeeAssetsCmd = &cobra.Command{
+	Use:   "fee-assets",
+	Short: "List the assets that can be used to pay fees.",
+	Args:  cobra.NoArgs,
+	Run:   queryFeeAssetsCmdHandler,
+}
+
+func queryFeeAssetsCmdHandler(c *cobra.Command, _ []string) {
+	flagHandler := cmd.CreateCliFlagHandlerWithUseConfigFlag(c, cmd.EnvPrefix, "network")
+	networkConfig := GetNetworkConfigFromFlags(flagHandler)
+	flagHandler.SetConfig(networkConfig)
+
+	printJSON := flagHandler.GetValue("json") == "true"
+	sequencerURL := flagHandler.GetValue("sequencer-url")
+	sequencerURL = AddPortToURL(sequencerURL)
+
+	assets, err := sequencer.GetAllowedFeeAssets(sequencerURL)
+	if err != nil {
+		log.WithError(err).Error("Error getting allowed fee assets")
+		panic(err)
+	}
+
+	printer := ui.ResultsPrinter{
+		Data:      assets,
+		PrintJSON: printJSON,
+	}
+	printer.Render()
+}
+
+// queryTxFeeCmd represents the `query tx-fee` command
+var queryTxFeeCmd = &cobra.Command{
+	Use:   "tx-fee --file [path]",
+	Short: "Get the fees for a transaction built from a manifest file.",
+	Long: `Get the fees the sequencer would charge for a transaction containing every
+action listed in a TOML or JSON manifest file, using the same manifest format
+as "tx build". The fees are listed per fee asset.`,
+	Args: cobra.NoArgs,
+	Run:  queryTxFeeCmdHandler,
+}
+
+func queryTxFeeCmdHandler(c *cobra.Command, _ []string) {
+	flagHandler := cmd.CreateCliFlagHandlerWithUseConfigFlag(c, cmd.EnvPrefix, "network")
+	networkConfig := GetNetworkConfigFromFlags(flagHandler)
+	flagHandler.SetConfig(networkConfig)
+
+	printJSON := flagHandler.GetValue("json") == "true"
+	sequencerURL := flagHandler.GetValue("sequencer-url")
+	sequencerURL = AddPortToURL(sequencerURL)
+	asset := flagHandler.GetValue("asset")
+	feeAsset := flagHandler.GetValue("fee-asset")
+	file := flagHandler.GetValue("file")
+	from := flagHandler.GetValue("from")
+
+	manifest, err := sequencer.LoadTxManifest(file)
+	if err != nil {
+		log.WithError(err).Error("Error loading transaction manifest")
+		panic(err)
+	}
+	actions, err := manifest.ToActions(sequencer.ManifestDefaults{
+		Asset:    asset,
+		FeeAsset: feeAsset,
+		Signer:   from,
+	})
+	if err != nil {
+		log.WithError(err).Error("Error building actions from manifest")
+		panic(err)
+	}
+
+	fees, err := sequencer.GetTransactionFee(actions, sequencerURL)
+	if err != nil {
+		log.WithError(err).Error("Error getting transaction fee")
+		panic(err)
+	}
+
+	printer := ui.ResultsPrinter{
+		Data:      fees,
+		PrintJSON: printJSON,
+	}
+	printer.Render()
+}
+
+// queryBridgeAccountCmd represents the `query bridge-account` command
+var queryBridgeAccountCmd = &cobra.Command{
+	Use:   "bridge-account [address]",
+	Short: "Get the rollup, asset and privileged addresses of a bridge account.",
+	Args:  cobra.ExactArgs(1),
+	Run:   queryBridgeAccountCmdHandler,
+}
+
+func queryBridgeAccountCmdHandler(c *cobra.Command, args []string) {
+	flagHandler := cmd.CreateCliFlagHandlerWithUseConfigFlag(c, cmd.EnvPrefix, "network")
+	networkConfig := GetNetworkConfigFromFlags(flagHandler)
+	flagHandler.SetConfig(networkConfig)
+
+	printJSON := flagHandler.GetValue("json") == "true"
+	sequencerURL := flagHandler.GetValue("sequencer-url")
+	sequencerURL = AddPortToURL(sequencerURL)
+
+	address := args[0]
+	if err := bech32m.Validate(address); err != nil {
+		log.WithError(err).Errorf("Invalid address: %s", address)
+		panic(err)
+	}
+
+	info, err := sequencer.GetBridgeAccountInfo(address, sequencerURL)
+	if err != nil {
+		log.WithError(err).Error("Error getting bridge account info")
+		panic(err)
+	}
+
+	printer := ui.ResultsPrinter{
+		Data:      info,
+		PrintJSON: printJSON,
+	}
+	printer.Render()
+}
+
+// queryBridgeLastTxCmd represents the `query bridge-last-tx` command
+var queryBridgeLastTxCmd = &cobra.Command{
+	Use:   "bridge-last-tx [address]",
+	Short: "Get the hash of the last transaction that used a bridge account.",
+	Args:  cobra.ExactArgs(1),
+	Run:   queryBridgeLastTxCmdHandler,
+}
+
+func queryBridgeLastTxCmdHandler(c *cobra.Command, args []string) {
+	flagHandler := cmd.CreateCliFlagHandlerWithUseConfigFlag(c, cmd.EnvPrefix, "network")
+	networkConfig := GetNetworkConfigFromFlags(flagHandler)
+	flagHandler.SetConfig(networkConfig)
+
+	printJSON := flagHandler.GetValue("json") == "true"
+	sequencerURL := flagHandler.GetValue("sequencer-url")
+	sequencerURL = AddPortToURL(sequencerURL)
+
+	address := args[0]
+	if err := bech32m.Validate(address); err != nil {
+		log.WithError(err).Errorf("Invalid address: %s", address)
+		panic(err)
+	}
+
+	last, err := sequencer.GetBridgeAccountLastTxHash(address, sequencerURL)
+	if err != nil {
+		log.WithError(err).Error("Error getting bridge account last transaction hash")
+		panic(err)
+	}
+
+	printer := ui.ResultsPrinter{
+		Data:      last,
+		PrintJSON: printJSON,
+	}
+	printer.Render()
+}
+
+// queryAppInfoCmd represents the `query app-info` command
+var queryAppInfoCmd = &cobra.Command{
+	Use:   "app-info",
+	Short: "Get the application version and last committed block of the sequencer.",
+	Args:  cobra.NoArgs,
+	Run:   queryAppInfoCmdHandler,
+}
+
+func queryAppInfoCmdHandler(c *cobra.Command, _ []string) {
+	flagHandler := cmd.CreateCliFlagHandlerWithUseConfigFlag(c, cmd.EnvPrefix, "network")
+	networkConfig := GetNetworkConfigFromFlags(flagHandler)
+	flagHandler.SetConfig(networkConfig)
+
+	printJSON := flagHandler.GetValue("json") == "true"
+	sequencerURL := flagHandler.GetValue("sequencer-url")
+	sequencerURL = AddPortToURL(sequencerURL)
+
+	info, err := sequencer.GetAppInfo(sequencerURL)
+	if err != nil {
+		log.WithError(err).Error("Error getting app info")
+		panic(err)
+	}
+
+	printer := ui.ResultsPrinter{
+		Data:      info,
+		PrintJSON: printJSON,
+	}
+	printer.Render()
+}
+
+func init() {
+	SequencerCmd.AddCommand(queryCmd)
+
+	for _, c := range []*cobra.Command{queryDenomCmd, queryFeeAssetsCmd, queryTxFeeCmd, queryBridgeAccountCmd, queryBridgeLastTxCmd, queryAppInfoCmd} {
+		queryCmd.AddCommand(c)
+		flagHandler := cmd.CreateCliFlagHandler(c, cmd.EnvPrefix)
+		flagHandler.BindStringFlag("network", cmd.DefaultTargetNetwork, "Configure the values to target a specific network.")
+		flagHandler.BindStringPFlag("sequencer-url", "u", DefaultSequencerURL, "The URL of the sequencer to query.")
+		flagHandler.BindBoolFlag("json", false, "Output in JSON format.")
+	}
+
+	qtfh := cmd.CreateCliFlagHandler(queryTxFeeCmd, cmd.EnvPrefix)
+	qtfh.BindStringFlag("asset", DefaultAsset, "The asset used by actions that do not set an asset.")
+	qtfh.BindStringFlag("fee-asset", DefaultFeeAsset, "The asset used for fees by actions that do not set a fee asset.")
+	qtfh.BindStringFlag("from", "", "The address of the signer. Used as the default return address for ICS20 withdrawals.")
+	qtfh.BindStringPFlag("file", "f", "", "Path to the TOML or JSON manifest listing the transaction's actions.")
+	if err := queryTxFeeCmd.MarkFlagRequired("file"); err != nil {
+		log.WithError(err).Fatal("Error marking file flag as required")
+	}
+}
@@ -10,13 +10,9 @@ var SequencerCmd = &cobra.Command{
 	Use:   "sequencer",
 	Short: "Interact with the Astria Shared Sequencer.",
 	Long: `Use this command to interact with the Astria Shared Sequencer.
-Generate accounts, get account balances, transfer tokens, and more.`,
-	// TODO - could be neat to have this base sequencer command print out details
-	//  about the sequencer and its current state, like block height, etc. should
-	//  take flag like `--sequencer-url`, where default would be url for local sequencer.
-	//Run: func(cmd *cobra.Command, args []string) {
-	//	fmt.Println("sequencer called")
-	//},
+Generate accounts, get account balances, transfer tokens, and more.
+
+Use "sequencer status" for a summary of the sequencer's current state.`,
 }
 
 func init() {
@@ -0,0 +1,55 @@
+package sequencer
+
+import (
+	"github.com/astriaorg/astria-cli-go/modules/cli/cmd"
+	"github.com/astriaorg/astria-cli-go/modules/cli/internal/sequencer"
+	"github.com/astriaorg/astria-cli-go/modules/cli/internal/ui"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+// statusCmd represents the status command
+var statusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show a summary of the sequencer node's state.",
+	Long: `Show the sequencer node's chain ID and version, its latest block, whether
+it is still catching up, and a summary of the validator set. If the node is a
+validator, its address and voting power are shown too.`,
+	Args: cobra.NoArgs,
+	Run:  statusCmdHandler,
+}
+
+func init() {
+	SequencerCmd.AddCommand(statusCmd)
+
+	flagHandler := cmd.CreateCliFlagHandler(statusCmd, cmd.EnvPrefix)
+	flagHandler.BindStringFlag("network", cmd.DefaultTargetNetwork, "Configure the values to target a specific network.")
+	flagHandler.BindStringPFlag("sequencer-url", "u", DefaultSequencerURL, "The URL of the sequencer to retrieve the status from.")
+	flagHandler.BindBoolFlag("json", false, "Output the status in JSON format.")
+}
+
+func statusCmdHandler(c *cobra.Command, _ []string) {
+	flagHandler := cmd.CreateCliFlagHandlerWithUseConfigFlag(c, cmd.EnvPrefix, "network")
+	networkConfig := GetNetworkConfigFromFlags(flagHandler)
+	flagHandler.SetConfig(networkConfig)
+
+	printJSON := flagHandler.GetValue("json") == "true"
+	sequencerURL := flagHandler.GetValue("sequencer-url")
+	sequencerURL = AddPortToURL(sequencerURL)
+
+	opts := sequencer.StatusOpts{
+		AddressPrefix: DefaultAddressPrefix,
+		SequencerURL:  sequencerURL,
+	}
+	status, err := sequencer.GetStatus(opts)
+	if err != nil {
+		log.WithError(err).Error("Error getting status")
+		panic(err)
+	}
+
+	printer := ui.ResultsPrinter{
+		Data:      status,
+		PrintJSON: printJSON,
+	}
+	printer.Render()
+}
@@ -0,0 +1,104 @@
+package sequencer
+
+import (
+	"os"
+
+	"github.com/astriaorg/astria-cli-go/modules/cli/cmd"
+	"github.com/astriaorg/astria-cli-go/modules/cli/internal/sequencer"
+	"github.com/astriaorg/astria-cli-go/modules/cli/internal/ui"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+// submitCmd represents the submit command
+var submitCmd = &cobra.Command{
+	Use:   "submit [rollup-name] [data | @file | -] [--keyfile | --keyring-address | --privkey]",
+	Short: "Submit raw data to a rollup.",
+	Long: `Submit raw data to a rollup on the sequencer. The rollup ID is derived
+from the rollup name. The data can be passed directly, read from a file with
+"@path/to/file", or read from stdin with "-" or by omitting the data argument.
+Use the --encoding flag to choose how the data is decoded.`,
+	Args: cobra.RangeArgs(1, 2),
+	Run:  submitCmdHandler,
+}
+
+func init() {
+	SequencerCmd.AddCommand(submitCmd)
+
+	flagHandler := cmd.CreateCliFlagHandler(submitCmd, cmd.EnvPrefix)
+	flagHandler.BindBoolFlag("json", false, "Output in JSON format.")
+	flagHandler.BindStringPFlag("sequencer-url", "u", DefaultSequencerURL, "The URL of the sequencer.")
+	flagHandler.BindStringPFlag("sequencer-chain-id", "c", DefaultSequencerChainID, "The chain ID of the sequencer.")
+	flagHandler.BindStringFlag("keyfile", "", "Path to secure keyfile for sender.")
+	flagHandler.BindStringFlag("keyring-address", "", "The address of the sender. Requires private key be stored in keyring.")
+	flagHandler.BindStringFlag("privkey", "", "The private key of the sender.")
+	flagHandler.BindStringFlag("fee-asset", DefaultFeeAsset, "The asset used for paying fees.")
+	flagHandler.BindStringFlag("encoding", "hex", "The encoding of the data. One of 'hex', 'base64', or 'raw'.")
+	flagHandler.BindStringFlag("network", cmd.DefaultTargetNetwork, "Configure the values to target a specific network.")
+	bindWaitFlags(flagHandler)
+	bindNonceManagerFlag(flagHandler)
+
+	submitCmd.MarkFlagsOneRequired("keyfile", "keyring-address", "privkey")
+	submitCmd.MarkFlagsMutuallyExclusive("keyfile", "keyring-address", "privkey")
+}
+
+func submitCmdHandler(c *cobra.Command, args []string) {
+	flagHandler := cmd.CreateCliFlagHandlerWithUseConfigFlag(c, cmd.EnvPrefix, "network")
+	networkConfig := GetNetworkConfigFromFlags(flagHandler)
+	flagHandler.SetConfig(networkConfig)
+
+	sequencerURL := flagHandler.GetValue("sequencer-url")
+	sequencerURL = AddPortToURL(sequencerURL)
+	feeAsset := flagHandler.GetValue("fee-asset")
+	sequencerChainID := flagHandler.GetValue("sequencer-chain-id")
+	encoding := flagHandler.GetValue("encoding")
+	wait := waitOptionsFromFlags(flagHandler)
+
+	printJSON := flagHandler.GetValue("json") == "true"
+
+	priv, err := GetPrivateKeyFromFlags(c)
+	if err != nil {
+		log.WithError(err).Error("Could not get private key from flags")
+		panic(err)
+	}
+	from, err := PrivateKeyFromText(priv)
+	if err != nil {
+		log.WithError(err).Error("Error decoding private key")
+		panic(err)
+	}
+
+	rollupName := args[0]
+
+	input := "-"
+	if len(args) == 2 {
+		input = args[1]
+	}
+	data, err := DataFromText(input, encoding, os.Stdin)
+	if err != nil {
+		log.WithError(err).Error("Error decoding rollup data")
+		panic(err)
+	}
+
+	opts := sequencer.SubmitRollupDataOpts{
+		Wait:             wait,
+		UseNonceManager:  flagHandler.GetValue("nonce-manager") == "true",
+		AddressPrefix:    DefaultAddressPrefix,
+		SequencerURL:     sequencerURL,
+		FromKey:          from,
+		SequencerChainID: sequencerChainID,
+		FeeAsset:         feeAsset,
+		RollupName:       rollupName,
+		Data:             data,
+	}
+	tx, err := sequencer.SubmitRollupData(opts)
+	if err != nil {
+		log.WithError(err).Error("Error submitting rollup data")
+		panic(err)
+	}
+
+	printer := ui.ResultsPrinter{
+		Data:      tx,
+		PrintJSON: printJSON,
+	}
+	printer.Render()
+}
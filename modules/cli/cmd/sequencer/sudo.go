@@ -0,0 +1,17 @@
+package sequencer
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// sudoCmd represents the sudo command
+var sudoCmd = &cobra.Command{
+	Use:   "sudo",
+	Short: "Sudo commands for privileged sequencer actions.",
+	Long: `Sudo commands perform privileged actions on the sequencer. The signer
+of these transactions must be the sequencer's sudo address.`,
+}
+
+func init() {
+	SequencerCmd.AddCommand(sudoCmd)
+}
@@ -0,0 +1,89 @@
+package sequencer
+
+import (
+	"github.com/astriaorg/astria-cli-go/modules/bech32m"
+	"github.com/astriaorg/astria-cli-go/modules/cli/cmd"
+	"github.com/astriaorg/astria-cli-go/modules/cli/internal/sequencer"
+	"github.com/astriaorg/astria-cli-go/modules/cli/internal/ui"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+// changeSudoAddressCmd represents the `sudo change-address` command
+var changeSudoAddressCmd = &cobra.Command{
+	Use:   "change-address [address] [--keyfile | --keyring-address | --privkey]",
+	Short: "Change the sudo address of the sequencer.",
+	Long: `Change the sudo address of the sequencer. The transaction must be signed
+by the current sudo address. After this transaction is executed, only the new
+sudo address can perform sudo actions.`,
+	Args: cobra.ExactArgs(1),
+	Run:  changeSudoAddressCmdHandler,
+}
+
+func init() {
+	sudoCmd.AddCommand(changeSudoAddressCmd)
+
+	flagHandler := cmd.CreateCliFlagHandler(changeSudoAddressCmd, cmd.EnvPrefix)
+	flagHandler.BindStringFlag("network", cmd.DefaultTargetNetwork, "Configure the values to target a specific network.")
+	flagHandler.BindStringPFlag("sequencer-url", "u", DefaultSequencerURL, "The URL of the sequencer.")
+	flagHandler.BindStringPFlag("sequencer-chain-id", "c", DefaultSequencerChainID, "The chain ID of the sequencer.")
+	flagHandler.BindBoolFlag("json", false, "Output in JSON format.")
+	bindWaitFlags(flagHandler)
+	bindNonceManagerFlag(flagHandler)
+	flagHandler.BindStringFlag("keyfile", "", "Path to secure keyfile for the current sudo account.")
+	flagHandler.BindStringFlag("keyring-address", "", "The address of the current sudo account. Requires private key be stored in keyring.")
+	flagHandler.BindStringFlag("privkey", "", "The private key of the current sudo account.")
+
+	changeSudoAddressCmd.MarkFlagsOneRequired("keyfile", "keyring-address", "privkey")
+	changeSudoAddressCmd.MarkFlagsMutuallyExclusive("keyfile", "keyring-address", "privkey")
+}
+
+func changeSudoAddressCmdHandler(c *cobra.Command, args []string) {
+	flagHandler := cmd.CreateCliFlagHandlerWithUseConfigFlag(c, cmd.EnvPrefix, "network")
+	networkConfig := GetNetworkConfigFromFlags(flagHandler)
+	flagHandler.SetConfig(networkConfig)
+
+	printJSON := flagHandler.GetValue("json") == "true"
+	sequencerURL := flagHandler.GetValue("sequencer-url")
+	sequencerURL = AddPortToURL(sequencerURL)
+	sequencerChainID := flagHandler.GetValue("sequencer-chain-id")
+	wait := waitOptionsFromFlags(flagHandler)
+
+	priv, err := GetPrivateKeyFromFlags(c)
+	if err != nil {
+		log.WithError(err).Error("Could not get private key from flags")
+		panic(err)
+	}
+	from, err := PrivateKeyFromText(priv)
+	if err != nil {
+		log.WithError(err).Error("Error decoding private key")
+		panic(err)
+	}
+
+	newAddress := args[0]
+	if err := bech32m.Validate(newAddress); err != nil {
+		log.WithError(err).Errorf("Invalid sudo address: %s", newAddress)
+		panic(err)
+	}
+
+	opts := sequencer.ChangeSudoAddressOpts{
+		Wait:             wait,
+		UseNonceManager:  flagHandler.GetValue("nonce-manager") == "true",
+		AddressPrefix:    DefaultAddressPrefix,
+		SequencerURL:     sequencerURL,
+		FromKey:          from,
+		UpdateAddress:    AddressFromText(newAddress),
+		SequencerChainID: sequencerChainID,
+	}
+	res, err := sequencer.ChangeSudoAddress(opts)
+	if err != nil {
+		log.WithError(err).Error("Error changing sudo address")
+		panic(err)
+	}
+
+	printer := ui.ResultsPrinter{
+		Data:      res,
+		PrintJSON: printJSON,
+	}
+	printer.Render()
+}
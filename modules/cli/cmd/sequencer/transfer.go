@@ -1,6 +1,9 @@
 package sequencer
 
 import (
+	"strconv"
+
+	txproto "buf.build/gen/go/astria/protocol-apis/protocolbuffers/go/astria/protocol/transaction/v1"
 	"github.com/astriaorg/astria-cli-go/modules/cli/cmd"
 	"github.com/astriaorg/astria-cli-go/modules/cli/internal/sequencer"
 	"github.com/astriaorg/astria-cli-go/modules/cli/internal/ui"
@@ -11,8 +14,14 @@ import (
 var transferCmd = &cobra.Command{
 	Use:   "transfer [amount] [to] [--keyfile | --keyring-address | --privkey]",
 	Short: "Transfer tokens from one account to another.",
-	Args:  cobra.ExactArgs(2),
-	Run:   transferCmdHandler,
+	Long: `Transfer tokens from one account to another.
+
+The amount is in base units of the asset, ie. "1500000" or "1500000utia", or
+in its display unit, ie. "1.5TIA", if one is configured for the asset in the
+network config. Amounts with more decimal places than the unit allows are
+rejected.`,
+	Args: cobra.ExactArgs(2),
+	Run:  transferCmdHandler,
 }
 
 func init() {
@@ -28,10 +37,39 @@ func init() {
 	flagHandler.BindStringFlag("asset", DefaultAsset, "The asset to be transferred.")
 	flagHandler.BindStringFlag("fee-asset", DefaultFeeAsset, "The asset used for paying fees.")
 	flagHandler.BindStringFlag("network", cmd.DefaultTargetNetwork, "Configure the values to target a specific network.")
-	flagHandler.BindBoolFlag("async", false, "If true, the function will return immediately. If false, the function will wait for the transaction to be seen on the network.")
+	bindWaitFlags(flagHandler)
+	bindNonceManagerFlag(flagHandler)
+	flagHandler.BindBoolFlag("dry-run", false, dryRunFlagDescription)
 
 	transferCmd.MarkFlagsOneRequired("keyfile", "keyring-address", "privkey")
 	transferCmd.MarkFlagsMutuallyExclusive("keyfile", "keyring-address", "privkey")
+
+	transferCmd.AddCommand(transferBatchCmd)
+
+	tbfh := cmd.CreateCliFlagHandler(transferBatchCmd, cmd.EnvPrefix)
+	tbfh.BindBoolFlag("json", false, "Output in JSON format.")
+	tbfh.BindBoolFlag("csv", false, "Output in CSV format.")
+	tbfh.BindStringPFlag("sequencer-url", "u", DefaultSequencerURL, "The URL of the sequencer.")
+	tbfh.BindStringPFlag("sequencer-chain-id", "c", DefaultSequencerChainID, "The chain ID of the sequencer.")
+	tbfh.BindStringFlag("keyfile", "", "Path to secure keyfile for sender.")
+	tbfh.BindStringFlag("keyring-address", "", "The address of the sender. Requires private key be stored in keyring.")
+	tbfh.BindStringFlag("privkey", "", "The private key of the sender.")
+	tbfh.BindStringFlag("asset", DefaultAsset, "The asset transferred by rows that do not set an asset.")
+	tbfh.BindStringFlag("fee-asset", DefaultFeeAsset, "The asset used for paying fees.")
+	tbfh.BindStringFlag("network", cmd.DefaultTargetNetwork, "Configure the values to target a specific network.")
+	tbfh.BindStringPFlag("file", "f", "", "Path to the CSV file listing the transfers.")
+	tbfh.BindStringFlag("results", "", "Path to the CSV results file to write, and to resume from if it exists.")
+	tbfh.BindStringFlag("concurrency", strconv.Itoa(sequencer.DefaultBatchConcurrency), "The number of transfers to broadcast at once.")
+	tbfh.BindStringFlag("retries", strconv.Itoa(sequencer.DefaultBatchRetries), "The number of times to retry a transfer after a transient failure.")
+	bindWaitFlags(tbfh)
+	bindNonceManagerFlag(tbfh)
+
+	transferBatchCmd.MarkFlagsOneRequired("keyfile", "keyring-address", "privkey")
+	transferBatchCmd.MarkFlagsMutuallyExclusive("keyfile", "keyring-address", "privkey")
+	transferBatchCmd.MarkFlagsMutuallyExclusive("json", "csv")
+	if err := transferBatchCmd.MarkFlagRequired("file"); err != nil {
+		log.WithError(err).Fatal("Error marking file flag as required")
+	}
 }
 
 func transferCmdHandler(c *cobra.Command, args []string) {
@@ -61,16 +99,17 @@ func transferCmdHandler(c *cobra.Command, args []string) {
 	to := args[1]
 	toAddress := AddressFromText(to)
 
-	amount, err := convertToUint128(args[0])
+	amount, err := amountFromText(args[0], networkConfig.AssetUnits(asset))
 	if err != nil {
-		log.WithError(err).Error("Error converting amount to Uint128 proto")
+		log.WithError(err).Error("Error parsing amount")
 		panic(err)
 	}
 
-	isAsync := flagHandler.GetValue("async") == "true"
+	wait := waitOptionsFromFlags(flagHandler)
 
 	opts := sequencer.TransferOpts{
-		IsAsync:          isAsync,
+		Wait:             wait,
+		UseNonceManager:  flagHandler.GetValue("nonce-manager") == "true",
 		AddressPrefix:    DefaultAddressPrefix,
 		SequencerURL:     sequencerURL,
 		FromKey:          from,
@@ -80,6 +119,17 @@ func transferCmdHandler(c *cobra.Command, args []string) {
 		FeeAsset:         feeAsset,
 		SequencerChainID: sequencerChainID,
 	}
+	if flagHandler.GetValue("dry-run") == "true" {
+		renderDryRun(sequencer.DryRunOpts{
+			AddressPrefix:    DefaultAddressPrefix,
+			SequencerURL:     sequencerURL,
+			FromKey:          from,
+			SequencerChainID: sequencerChainID,
+			Actions:          []*txproto.Action{sequencer.TransferAction(opts)},
+		}, printJSON)
+		return
+	}
+
 	tx, err := sequencer.Transfer(opts)
 	if err != nil {
 		log.WithError(err).Error("Error transferring tokens")
@@ -92,3 +142,119 @@ func transferCmdHandler(c *cobra.Command, args []string) {
 	}
 	printer.Render()
 }
+
+// transferBatchCmd represents the `transfer batch` command
+var transferBatchCmd = &cobra.Command{
+	Use:   "batch --file [path] [--results [path]] [--keyfile | --keyring-address | --privkey]",
+	Short: "Send a transfer for each row of a CSV file.",
+	Long: `Send a transfer for each row of a CSV file with the columns address, amount
+and an optional asset. Rows without an asset transfer --asset. A header row
+starting with "address" and lines starting with "#" are ignored.
+
+Nonces are reserved once and assigned to the transfers in file order, so they
+can be broadcast concurrently. Transient broadcast failures are retried. If a
+transfer fails, the transfers that were not started yet are skipped.
+
+The result of every transfer is printed at the end, and written to the
+--results file along with the signed transactions as the batch progresses.
+Running the command again with the same --results file resumes the batch:
+transfers that were committed are not sent again, and the other transactions
+are looked up. A transaction that is not found is broadcast again unchanged
+while its nonce is unused, so a transfer is never executed twice. If its nonce
+was used, the transfer is marked "unknown" and must be checked manually; remove
+its row from the results file to send it again.
+
+Example file:
+
+  address,amount,asset
+  astria1...,1000,ntia
+  astria1...,2500`,
+	Args: cobra.NoArgs,
+	Run:  transferBatchCmdHandler,
+}
+
+func transferBatchCmdHandler(c *cobra.Command, _ []string) {
+	flagHandler := cmd.CreateCliFlagHandlerWithUseConfigFlag(c, cmd.EnvPrefix, "network")
+	networkConfig := GetNetworkConfigFromFlags(flagHandler)
+	flagHandler.SetConfig(networkConfig)
+
+	sequencerURL := flagHandler.GetValue("sequencer-url")
+	sequencerURL = AddPortToURL(sequencerURL)
+	asset := flagHandler.GetValue("asset")
+	feeAsset := flagHandler.GetValue("fee-asset")
+	sequencerChainID := flagHandler.GetValue("sequencer-chain-id")
+	file := flagHandler.GetValue("file")
+	results := flagHandler.GetValue("results")
+	wait := waitOptionsFromFlags(flagHandler)
+
+	printJSON := flagHandler.GetValue("json") == "true"
+	printCSV := flagHandler.GetValue("csv") == "true"
+
+	concurrency, err := strconv.Atoi(flagHandler.GetValue("concurrency"))
+	if err != nil {
+		log.WithError(err).Error("Error parsing concurrency to int")
+		panic(err)
+	}
+	retries, err := strconv.Atoi(flagHandler.GetValue("retries"))
+	if err != nil {
+		log.WithError(err).Error("Error parsing retries to int")
+		panic(err)
+	}
+
+	priv, err := GetPrivateKeyFromFlags(c)
+	if err != nil {
+		log.WithError(err).Error("Could not get private key from flags")
+		panic(err)
+	}
+	from, err := PrivateKeyFromText(priv)
+	if err != nil {
+		log.WithError(err).Error("Error decoding private key")
+		panic(err)
+	}
+
+	rows, err := sequencer.LoadBatchTransferFile(file)
+	if err != nil {
+		log.WithError(err).Error("Error loading transfer file")
+		panic(err)
+	}
+
+	opts := sequencer.BatchTransferOpts{
+		Wait:             wait,
+		UseNonceManager:  flagHandler.GetValue("nonce-manager") == "true",
+		AddressPrefix:    DefaultAddressPrefix,
+		SequencerURL:     sequencerURL,
+		FromKey:          from,
+		SequencerChainID: sequencerChainID,
+		Asset:            asset,
+		FeeAsset:         feeAsset,
+		Rows:             rows,
+		ResultsPath:      results,
+		Concurrency:      concurrency,
+		Retries:          retries,
+	}
+	batch, err := sequencer.BatchTransfer(opts)
+	if err != nil {
+		log.WithError(err).Error("Error sending batch transfer")
+		panic(err)
+	}
+
+	printer := ui.ResultsPrinter{
+		Data:      batch,
+		PrintJSON: printJSON,
+		PrintCSV:  printCSV,
+	}
+	printer.Render()
+
+	for _, r := range batch.Results {
+		if r.Status == sequencer.BatchStatusFailed || r.Status == sequencer.BatchStatusSkipped {
+			log.Warn("Some transfers were not sent. Fix the cause and run the command again with the same --results file to resume.")
+			break
+		}
+	}
+	for _, r := range batch.Results {
+		if r.Status == sequencer.BatchStatusUnknown {
+			log.Warn("The outcome of some transfers is unknown. Check whether they were committed before sending them again.")
+			break
+		}
+	}
+}
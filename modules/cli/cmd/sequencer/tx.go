@@ -0,0 +1,449 @@
+package sequencer
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/astriaorg/astria-cli-go/modules/cli/cmd"
+	"github.com/astriaorg/astria-cli-go/modules/cli/internal/sequencer"
+	"github.com/astriaorg/astria-cli-go/modules/cli/internal/ui"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+// txCmd represents the tx command
+var txCmd = &cobra.Command{
+	Use:   "tx",
+	Short: "Build and send transactions.",
+}
+
+// txBuildCmd represents the `tx build` command
+var txBuildCmd = &cobra.Command{
+	Use:   "build --file [path] [--keyfile | --keyring-address | --privkey]",
+	Short: "Build, sign, and broadcast a transaction from a manifest file.",
+	Long: `Build a single transaction containing every action listed in a TOML or
+JSON manifest file, then sign and broadcast it. All actions share one nonce and
+are executed atomically.
+
+Each action is an entry in the "actions" array with a "type" field. Supported
+types are: transfer, rollup_data_submission, init_bridge_account, bridge_lock,
+bridge_unlock, bridge_sudo_change, ics20_withdrawal, sudo_address_change,
+validator_update, ibc_relayer_add, ibc_relayer_remove, fee_asset_add, and
+fee_asset_remove.
+
+Example TOML manifest:
+
+  [[actions]]
+  type = "transfer"
+  to = "astria1..."
+  amount = "1000"
+
+  [[actions]]
+  type = "rollup_data_submission"
+  rollup_name = "my-rollup"
+  data = "deadbeef"`,
+	Args: cobra.NoArgs,
+	Run:  txBuildCmdHandler,
+}
+
+func txBuildCmdHandler(c *cobra.Command, _ []string) {
+	flagHandler := cmd.CreateCliFlagHandlerWithUseConfigFlag(c, cmd.EnvPrefix, "network")
+	networkConfig := GetNetworkConfigFromFlags(flagHandler)
+	flagHandler.SetConfig(networkConfig)
+
+	printJSON := flagHandler.GetValue("json") == "true"
+	sequencerURL := flagHandler.GetValue("sequencer-url")
+	sequencerURL = AddPortToURL(sequencerURL)
+	sequencerChainID := flagHandler.GetValue("sequencer-chain-id")
+	asset := flagHandler.GetValue("asset")
+	feeAsset := flagHandler.GetValue("fee-asset")
+	wait := waitOptionsFromFlags(flagHandler)
+	file := flagHandler.GetValue("file")
+
+	priv, err := GetPrivateKeyFromFlags(c)
+	if err != nil {
+		log.WithError(err).Error("Could not get private key from flags")
+		panic(err)
+	}
+	from, err := PrivateKeyFromText(priv)
+	if err != nil {
+		log.WithError(err).Error("Error decoding private key")
+		panic(err)
+	}
+	fromAccount, err := sequencer.NewAccountFromPrivKey(DefaultAddressPrefix, from)
+	if err != nil {
+		log.WithError(err).Error("Error constructing address from private key")
+		panic(err)
+	}
+
+	manifest, err := sequencer.LoadTxManifest(file)
+	if err != nil {
+		log.WithError(err).Error("Error loading transaction manifest")
+		panic(err)
+	}
+	actions, err := manifest.ToActions(sequencer.ManifestDefaults{
+		Asset:    asset,
+		FeeAsset: feeAsset,
+		Signer:   fromAccount.Address.String(),
+	})
+	if err != nil {
+		log.WithError(err).Error("Error building actions from manifest")
+		panic(err)
+	}
+
+	opts := sequencer.BuildTxOpts{
+		Wait:             wait,
+		UseNonceManager:  flagHandler.GetValue("nonce-manager") == "true",
+		AddressPrefix:    DefaultAddressPrefix,
+		SequencerURL:     sequencerURL,
+		FromKey:          from,
+		SequencerChainID: sequencerChainID,
+		Actions:          actions,
+	}
+	tx, err := sequencer.BuildAndSendTx(opts)
+	if err != nil {
+		log.WithError(err).Error("Error sending transaction")
+		panic(err)
+	}
+
+	printer := ui.ResultsPrinter{
+		Data:      tx,
+		PrintJSON: printJSON,
+	}
+	printer.Render()
+}
+
+// txCreateCmd represents the `tx create` command
+var txCreateCmd = &cobra.Command{
+	Use:   "create --file [path] --output [path] --nonce [nonce] --chain-id [chain-id]",
+	Short: "Create an unsigned transaction from a manifest file.",
+	Long: `Create an unsigned transaction containing every action listed in a TOML
+or JSON manifest file, using the same manifest format as "tx build". The
+transaction is written to the output path without contacting the sequencer, so
+the nonce and chain ID must be given explicitly.
+
+ICS20 withdrawals time out at their "timeout_time", or after their "timeout"
+from when the transaction is created. Withdrawals that set neither time out
+after --ibc-timeout, which defaults to a day to leave time to carry the
+transaction to an offline signer and back before it is broadcast.
+
+The transaction is written as protobuf binary, and a protobuf JSON rendering of
+it for review is written alongside it, to the output path with ".json"
+appended.`,
+	Args: cobra.NoArgs,
+	Run:  txCreateCmdHandler,
+}
+
+func txCreateCmdHandler(c *cobra.Command, _ []string) {
+	flagHandler := cmd.CreateCliFlagHandlerWithUseConfigFlag(c, cmd.EnvPrefix, "network")
+	networkConfig := GetNetworkConfigFromFlags(flagHandler)
+	flagHandler.SetConfig(networkConfig)
+
+	printJSON := flagHandler.GetValue("json") == "true"
+	asset := flagHandler.GetValue("asset")
+	feeAsset := flagHandler.GetValue("fee-asset")
+	chainID := flagHandler.GetValue("chain-id")
+	file := flagHandler.GetValue("file")
+	output := flagHandler.GetValue("output")
+	from := flagHandler.GetValue("from")
+
+	nonce, err := strconv.ParseUint(flagHandler.GetValue("nonce"), 10, 32)
+	if err != nil {
+		log.WithError(err).Error("Error parsing nonce to uint32")
+		panic(err)
+	}
+	ibcTimeout, err := time.ParseDuration(flagHandler.GetValue("ibc-timeout"))
+	if err != nil {
+		log.WithError(err).Error("Error parsing ibc-timeout to duration")
+		panic(err)
+	}
+
+	manifest, err := sequencer.LoadTxManifest(file)
+	if err != nil {
+		log.WithError(err).Error("Error loading transaction manifest")
+		panic(err)
+	}
+	actions, err := manifest.ToActions(sequencer.ManifestDefaults{
+		Asset:      asset,
+		FeeAsset:   feeAsset,
+		Signer:     from,
+		IbcTimeout: ibcTimeout,
+	})
+	if err != nil {
+		log.WithError(err).Error("Error building actions from manifest")
+		panic(err)
+	}
+
+	opts := sequencer.CreateTxOpts{
+		SequencerChainID: chainID,
+		Nonce:            uint32(nonce),
+		Actions:          actions,
+		OutputPath:       output,
+	}
+	tx, err := sequencer.CreateTx(opts)
+	if err != nil {
+		log.WithError(err).Error("Error creating transaction")
+		panic(err)
+	}
+
+	printer := ui.ResultsPrinter{
+		Data:      tx,
+		PrintJSON: printJSON,
+	}
+	printer.Render()
+}
+
+// txSignCmd represents the `tx sign` command
+var txSignCmd = &cobra.Command{
+	Use:   "sign --file [path] --output [path] [--keyfile | --keyring-address | --privkey]",
+	Short: "Sign an unsigned transaction offline.",
+	Long: `Sign an unsigned transaction created with "tx create" and write the
+signed transaction to the output path. Signing never contacts the sequencer, so
+it can be done on a machine without network access.
+
+The signed transaction is written as protobuf binary, and a protobuf JSON
+rendering of it for review is written alongside it, to the output path with
+".json" appended.`,
+	Args: cobra.NoArgs,
+	Run:  txSignCmdHandler,
+}
+
+func txSignCmdHandler(c *cobra.Command, _ []string) {
+	flagHandler := cmd.CreateCliFlagHandler(c, cmd.EnvPrefix)
+
+	printJSON := flagHandler.GetValue("json") == "true"
+	file := flagHandler.GetValue("file")
+	output := flagHandler.GetValue("output")
+
+	priv, err := GetPrivateKeyFromFlags(c)
+	if err != nil {
+		log.WithError(err).Error("Could not get private key from flags")
+		panic(err)
+	}
+	from, err := PrivateKeyFromText(priv)
+	if err != nil {
+		log.WithError(err).Error("Error decoding private key")
+		panic(err)
+	}
+
+	opts := sequencer.SignTxOpts{
+		AddressPrefix: DefaultAddressPrefix,
+		FromKey:       from,
+		InputPath:     file,
+		OutputPath:    output,
+	}
+	tx, err := sequencer.SignTx(opts)
+	if err != nil {
+		log.WithError(err).Error("Error signing transaction")
+		panic(err)
+	}
+
+	printer := ui.ResultsPrinter{
+		Data:      tx,
+		PrintJSON: printJSON,
+	}
+	printer.Render()
+}
+
+// txBroadcastCmd represents the `tx broadcast` command
+var txBroadcastCmd = &cobra.Command{
+	Use:   "broadcast --file [path]",
+	Short: "Broadcast a signed transaction.",
+	Long: `Broadcast a signed transaction created with "tx sign" to the
+sequencer. The transaction is decoded before it is broadcast, and is rejected
+if its signature does not verify against its public key or if its chain ID is
+not --sequencer-chain-id.`,
+	Args: cobra.NoArgs,
+	Run:  txBroadcastCmdHandler,
+}
+
+func txBroadcastCmdHandler(c *cobra.Command, _ []string) {
+	flagHandler := cmd.CreateCliFlagHandlerWithUseConfigFlag(c, cmd.EnvPrefix, "network")
+	networkConfig := GetNetworkConfigFromFlags(flagHandler)
+	flagHandler.SetConfig(networkConfig)
+
+	printJSON := flagHandler.GetValue("json") == "true"
+	sequencerURL := flagHandler.GetValue("sequencer-url")
+	sequencerURL = AddPortToURL(sequencerURL)
+	sequencerChainID := flagHandler.GetValue("sequencer-chain-id")
+	wait := waitOptionsFromFlags(flagHandler)
+	file := flagHandler.GetValue("file")
+
+	opts := sequencer.BroadcastTxOpts{
+		Wait:             wait,
+		AddressPrefix:    DefaultAddressPrefix,
+		SequencerURL:     sequencerURL,
+		SequencerChainID: sequencerChainID,
+		InputPath:        file,
+	}
+	tx, err := sequencer.BroadcastSignedTx(opts)
+	if err != nil {
+		log.WithError(err).Error("Error broadcasting transaction")
+		panic(err)
+	}
+
+	printer := ui.ResultsPrinter{
+		Data:      tx,
+		PrintJSON: printJSON,
+	}
+	printer.Render()
+}
+
+// txDecodeCmd represents the `tx decode` command
+var txDecodeCmd = &cobra.Command{
+	Use:   "decode [hex | base64 | @file]",
+	Short: "Decode and inspect a signed transaction.",
+	Long: `Decode a signed transaction and print its signer, parameters and actions.
+The transaction can be given as hex or base64 encoded protobuf, or as @path to
+a file. Files ending in ".json" are read as protobuf JSON, all other files are
+read as protobuf binary.
+
+Without --encoding, 0x prefixed input is read as hex, and other input is read
+in whichever of hex and base64 it is valid in. Input valid in both, such as
+hex of a length divisible by four, must be 0x prefixed or given an --encoding.
+
+The transaction's signature is verified against its embedded public key.`,
+	Args: cobra.ExactArgs(1),
+	Run:  txDecodeCmdHandler,
+}
+
+func txDecodeCmdHandler(c *cobra.Command, args []string) {
+	flagHandler := cmd.CreateCliFlagHandler(c, cmd.EnvPrefix)
+	printJSON := flagHandler.GetValue("json") == "true"
+
+	opts := sequencer.DecodeTxOpts{
+		AddressPrefix: DefaultAddressPrefix,
+		Input:         args[0],
+		Encoding:      flagHandler.GetValue("encoding"),
+	}
+	tx, err := sequencer.DecodeTransaction(opts)
+	if err != nil {
+		log.WithError(err).Error("Error decoding transaction")
+		panic(err)
+	}
+
+	printer := ui.ResultsPrinter{
+		Data:      tx,
+		PrintJSON: printJSON,
+	}
+	printer.Render()
+}
+
+// txStatusCmd represents the `tx status` command
+var txStatusCmd = &cobra.Command{
+	Use:   "status [hash]",
+	Short: "Look up the status of a transaction by hash.",
+	Long: `Look up a transaction by its hash and print the height of the block it was
+included in, its result code, log, events and decoded actions. Useful for
+confirming the outcome of transactions sent with --wait=none.`,
+	Args: cobra.ExactArgs(1),
+	Run:  txStatusCmdHandler,
+}
+
+func txStatusCmdHandler(c *cobra.Command, args []string) {
+	flagHandler := cmd.CreateCliFlagHandlerWithUseConfigFlag(c, cmd.EnvPrefix, "network")
+	networkConfig := GetNetworkConfigFromFlags(flagHandler)
+	flagHandler.SetConfig(networkConfig)
+
+	printJSON := flagHandler.GetValue("json") == "true"
+	sequencerURL := flagHandler.GetValue("sequencer-url")
+	sequencerURL = AddPortToURL(sequencerURL)
+
+	opts := sequencer.TxStatusOpts{
+		AddressPrefix: DefaultAddressPrefix,
+		SequencerURL:  sequencerURL,
+		TxHash:        args[0],
+	}
+	tx, err := sequencer.GetTxStatus(opts)
+	if err != nil {
+		log.WithError(err).Error("Error getting transaction status")
+		panic(err)
+	}
+
+	printer := ui.ResultsPrinter{
+		Data:      tx,
+		PrintJSON: printJSON,
+	}
+	printer.Render()
+}
+
+func init() {
+	SequencerCmd.AddCommand(txCmd)
+
+	txCmd.AddCommand(txBuildCmd)
+	tbfh := cmd.CreateCliFlagHandler(txBuildCmd, cmd.EnvPrefix)
+	tbfh.BindStringFlag("network", cmd.DefaultTargetNetwork, "Configure the values to target a specific network.")
+	tbfh.BindStringPFlag("sequencer-url", "u", DefaultSequencerURL, "The URL of the sequencer.")
+	tbfh.BindStringPFlag("sequencer-chain-id", "c", DefaultSequencerChainID, "The chain ID of the sequencer.")
+	tbfh.BindStringFlag("asset", DefaultAsset, "The asset used by actions that do not set an asset.")
+	tbfh.BindStringFlag("fee-asset", DefaultFeeAsset, "The asset used for fees by actions that do not set a fee asset.")
+	tbfh.BindStringPFlag("file", "f", "", "Path to the TOML or JSON manifest listing the transaction's actions.")
+	tbfh.BindBoolFlag("json", false, "Output in JSON format.")
+	bindWaitFlags(tbfh)
+	bindNonceManagerFlag(tbfh)
+	tbfh.BindStringFlag("keyfile", "", "Path to secure keyfile for the signer.")
+	tbfh.BindStringFlag("keyring-address", "", "The address of the signer. Requires private key be stored in keyring.")
+	tbfh.BindStringFlag("privkey", "", "The private key of the signer.")
+	txBuildCmd.MarkFlagsOneRequired("keyfile", "keyring-address", "privkey")
+	txBuildCmd.MarkFlagsMutuallyExclusive("keyfile", "keyring-address", "privkey")
+	if err := txBuildCmd.MarkFlagRequired("file"); err != nil {
+		log.WithError(err).Fatal("Error marking file flag as required")
+	}
+
+	txCmd.AddCommand(txCreateCmd)
+	tcfh := cmd.CreateCliFlagHandler(txCreateCmd, cmd.EnvPrefix)
+	tcfh.BindStringFlag("network", cmd.DefaultTargetNetwork, "Configure the values to target a specific network.")
+	tcfh.BindStringFlag("asset", DefaultAsset, "The asset used by actions that do not set an asset.")
+	tcfh.BindStringFlag("fee-asset", DefaultFeeAsset, "The asset used for fees by actions that do not set a fee asset.")
+	tcfh.BindStringFlag("chain-id", "", "The chain ID of the sequencer the transaction is for.")
+	tcfh.BindStringFlag("nonce", "", "The nonce of the signing account to use for the transaction.")
+	tcfh.BindStringFlag("from", "", "The address of the signer. Used as the default return address for ICS20 withdrawals.")
+	tcfh.BindStringFlag("ibc-timeout", DefaultOfflineIbcTimeout.String(), "How long after creation ICS20 withdrawals that set no timeout time out, eg. 24h.")
+	tcfh.BindStringPFlag("file", "f", "", "Path to the TOML or JSON manifest listing the transaction's actions.")
+	tcfh.BindStringPFlag("output", "o", "", "Path to write the unsigned transaction to. Must not end in .json.")
+	tcfh.BindBoolFlag("json", false, "Output in JSON format.")
+	for _, f := range []string{"chain-id", "nonce", "file", "output"} {
+		if err := txCreateCmd.MarkFlagRequired(f); err != nil {
+			log.WithError(err).Fatalf("Error marking %s flag as required", f)
+		}
+	}
+
+	txCmd.AddCommand(txSignCmd)
+	tsfh := cmd.CreateCliFlagHandler(txSignCmd, cmd.EnvPrefix)
+	tsfh.BindStringPFlag("file", "f", "", "Path to the unsigned transaction to sign.")
+	tsfh.BindStringPFlag("output", "o", "", "Path to write the signed transaction to. Must not end in .json.")
+	tsfh.BindBoolFlag("json", false, "Output in JSON format.")
+	tsfh.BindStringFlag("keyfile", "", "Path to secure keyfile for the signer.")
+	tsfh.BindStringFlag("keyring-address", "", "The address of the signer. Requires private key be stored in keyring.")
+	tsfh.BindStringFlag("privkey", "", "The private key of the signer.")
+	txSignCmd.MarkFlagsOneRequired("keyfile", "keyring-address", "privkey")
+	txSignCmd.MarkFlagsMutuallyExclusive("keyfile", "keyring-address", "privkey")
+	for _, f := range []string{"file", "output"} {
+		if err := txSignCmd.MarkFlagRequired(f); err != nil {
+			log.WithError(err).Fatalf("Error marking %s flag as required", f)
+		}
+	}
+
+	txCmd.AddCommand(txBroadcastCmd)
+	tbcfh := cmd.CreateCliFlagHandler(txBroadcastCmd, cmd.EnvPrefix)
+	tbcfh.BindStringFlag("network", cmd.DefaultTargetNetwork, "Configure the values to target a specific network.")
+	tbcfh.BindStringPFlag("sequencer-url", "u", DefaultSequencerURL, "The URL of the sequencer.")
+	tbcfh.BindStringPFlag("sequencer-chain-id", "c", DefaultSequencerChainID, "The chain ID of the sequencer.")
+	tbcfh.BindStringPFlag("file", "f", "", "Path to the signed transaction to broadcast.")
+	tbcfh.BindBoolFlag("json", false, "Output in JSON format.")
+	bindWaitFlags(tbcfh)
+	if err := txBroadcastCmd.MarkFlagRequired("file"); err != nil {
+		log.WithError(err).Fatal("Error marking file flag as required")
+	}
+
+	txCmd.AddCommand(txDecodeCmd)
+	tdcfh := cmd.CreateCliFlagHandler(txDecodeCmd, cmd.EnvPrefix)
+	tdcfh.BindStringFlag("encoding", "", "The encoding of the transaction, either hex or base64. Detected from the input if unset.")
+	tdcfh.BindBoolFlag("json", false, "Output in JSON format.")
+
+	txCmd.AddCommand(txStatusCmd)
+	tstfh := cmd.CreateCliFlagHandler(txStatusCmd, cmd.EnvPrefix)
+	tstfh.BindStringFlag("network", cmd.DefaultTargetNetwork, "Configure the values to target a specific network.")
+	tstfh.BindStringPFlag("sequencer-url", "u", DefaultSequencerURL, "The URL of the sequencer.")
+	tstfh.BindBoolFlag("json", false, "Output in JSON format.")
+}
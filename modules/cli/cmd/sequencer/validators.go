@@ -0,0 +1,73 @@
+package sequencer
+
+import (
+	"strconv"
+
+	"github.com/astriaorg/astria-cli-go/modules/cli/cmd"
+	"github.com/astriaorg/astria-cli-go/modules/cli/internal/sequencer"
+	"github.com/astriaorg/astria-cli-go/modules/cli/internal/ui"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+// validatorsCmd represents the validators command
+var validatorsCmd = &cobra.Command{
+	Use:   "validators [--height [height]]",
+	Short: "List the sequencer's validator set.",
+	Long: `List the validators of the sequencer at the latest block, or at --height,
+with their addresses, voting power and proposer priority. The public keys are
+hex encoded, as expected by the sudo validator-update command.`,
+	Args: cobra.NoArgs,
+	Run:  validatorsCmdHandler,
+}
+
+func init() {
+	SequencerCmd.AddCommand(validatorsCmd)
+
+	flagHandler := cmd.CreateCliFlagHandler(validatorsCmd, cmd.EnvPrefix)
+	flagHandler.BindStringFlag("network", cmd.DefaultTargetNetwork, "Configure the values to target a specific network.")
+	flagHandler.BindStringPFlag("sequencer-url", "u", DefaultSequencerURL, "The URL of the sequencer to retrieve the validators from.")
+	flagHandler.BindStringFlag("height", "", "The block height to get the validator set at. Defaults to the latest block.")
+	flagHandler.BindBoolFlag("json", false, "Output the validators in JSON format.")
+	flagHandler.BindBoolFlag("csv", false, "Output the validators in CSV format.")
+	validatorsCmd.MarkFlagsMutuallyExclusive("json", "csv")
+}
+
+func validatorsCmdHandler(c *cobra.Command, _ []string) {
+	flagHandler := cmd.CreateCliFlagHandlerWithUseConfigFlag(c, cmd.EnvPrefix, "network")
+	networkConfig := GetNetworkConfigFromFlags(flagHandler)
+	flagHandler.SetConfig(networkConfig)
+
+	printJSON := flagHandler.GetValue("json") == "true"
+	printCSV := flagHandler.GetValue("csv") == "true"
+	sequencerURL := flagHandler.GetValue("sequencer-url")
+	sequencerURL = AddPortToURL(sequencerURL)
+
+	height := int64(0)
+	if h := flagHandler.GetValue("height"); h != "" {
+		var err error
+		height, err = strconv.ParseInt(h, 10, 64)
+		if err != nil {
+			log.WithError(err).Error("Error parsing height to int64")
+			panic(err)
+		}
+	}
+
+	opts := sequencer.ValidatorsOpts{
+		AddressPrefix: DefaultAddressPrefix,
+		SequencerURL:  sequencerURL,
+		Height:        height,
+	}
+	validators, err := sequencer.GetValidators(opts)
+	if err != nil {
+		log.WithError(err).Error("Error getting validators")
+		panic(err)
+	}
+
+	printer := ui.ResultsPrinter{
+		Data:      validators,
+		PrintJSON: printJSON,
+		PrintCSV:  printCSV,
+	}
+	printer.Render()
+}
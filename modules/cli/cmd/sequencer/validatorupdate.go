@@ -0,0 +1,108 @@
+package sequencer
+
+import (
+	"crypto/ed25519"
+	"fmt"
+	"strconv"
+
+	"github.com/astriaorg/astria-cli-go/modules/cli/cmd"
+	"github.com/astriaorg/astria-cli-go/modules/cli/internal/sequencer"
+	"github.com/astriaorg/astria-cli-go/modules/cli/internal/ui"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+// validatorUpdateCmd represents the `sudo validator-update` command
+var validatorUpdateCmd = &cobra.Command{
+	Use:   "validator-update [pubkey] [power] [--keyfile | --keyring-address | --privkey]",
+	Short: "Update the voting power of a sequencer validator.",
+	Long: `Update the voting power of a sequencer validator. The validator is
+identified by its hex encoded ed25519 public key. Setting the power to 0 removes
+the validator from the validator set. The transaction must be signed by the
+sudo address.`,
+	Args: cobra.ExactArgs(2),
+	Run:  validatorUpdateCmdHandler,
+}
+
+func init() {
+	sudoCmd.AddCommand(validatorUpdateCmd)
+
+	flagHandler := cmd.CreateCliFlagHandler(validatorUpdateCmd, cmd.EnvPrefix)
+	flagHandler.BindStringFlag("network", cmd.DefaultTargetNetwork, "Configure the values to target a specific network.")
+	flagHandler.BindStringPFlag("sequencer-url", "u", DefaultSequencerURL, "The URL of the sequencer.")
+	flagHandler.BindStringPFlag("sequencer-chain-id", "c", DefaultSequencerChainID, "The chain ID of the sequencer.")
+	flagHandler.BindBoolFlag("json", false, "Output in JSON format.")
+	bindWaitFlags(flagHandler)
+	bindNonceManagerFlag(flagHandler)
+	flagHandler.BindStringFlag("keyfile", "", "Path to secure keyfile for the sudo account.")
+	flagHandler.BindStringFlag("keyring-address", "", "The address of the sudo account. Requires private key be stored in keyring.")
+	flagHandler.BindStringFlag("privkey", "", "The private key of the sudo account.")
+
+	validatorUpdateCmd.MarkFlagsOneRequired("keyfile", "keyring-address", "privkey")
+	validatorUpdateCmd.MarkFlagsMutuallyExclusive("keyfile", "keyring-address", "privkey")
+}
+
+func validatorUpdateCmdHandler(c *cobra.Command, args []string) {
+	flagHandler := cmd.CreateCliFlagHandlerWithUseConfigFlag(c, cmd.EnvPrefix, "network")
+	networkConfig := GetNetworkConfigFromFlags(flagHandler)
+	flagHandler.SetConfig(networkConfig)
+
+	printJSON := flagHandler.GetValue("json") == "true"
+	sequencerURL := flagHandler.GetValue("sequencer-url")
+	sequencerURL = AddPortToURL(sequencerURL)
+	sequencerChainID := flagHandler.GetValue("sequencer-chain-id")
+	wait := waitOptionsFromFlags(flagHandler)
+
+	priv, err := GetPrivateKeyFromFlags(c)
+	if err != nil {
+		log.WithError(err).Error("Could not get private key from flags")
+		panic(err)
+	}
+	from, err := PrivateKeyFromText(priv)
+	if err != nil {
+		log.WithError(err).Error("Error decoding private key")
+		panic(err)
+	}
+
+	pubKey, err := PublicKeyFromText(args[0])
+	if err != nil {
+		log.WithError(err).Error("Error decoding validator public key")
+		panic(err)
+	}
+	if len(pubKey) != ed25519.PublicKeySize {
+		log.Errorf("Validator public key must be %d bytes, got %d", ed25519.PublicKeySize, len(pubKey))
+		panic(fmt.Errorf("invalid validator public key length: %d", len(pubKey)))
+	}
+
+	power, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil {
+		log.WithError(err).Error("Error parsing validator power to int64")
+		panic(err)
+	}
+	if power < 0 {
+		log.Errorf("Validator power must not be negative, got %d", power)
+		panic(fmt.Errorf("negative validator power not allowed"))
+	}
+
+	opts := sequencer.UpdateValidatorOpts{
+		Wait:             wait,
+		UseNonceManager:  flagHandler.GetValue("nonce-manager") == "true",
+		AddressPrefix:    DefaultAddressPrefix,
+		SequencerURL:     sequencerURL,
+		FromKey:          from,
+		PubKey:           pubKey,
+		Power:            power,
+		SequencerChainID: sequencerChainID,
+	}
+	res, err := sequencer.UpdateValidator(opts)
+	if err != nil {
+		log.WithError(err).Error("Error updating validator")
+		panic(err)
+	}
+
+	printer := ui.ResultsPrinter{
+		Data:      res,
+		PrintJSON: printJSON,
+	}
+	printer.Render()
+}
@@ -0,0 +1,89 @@
+package sequencer
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/astriaorg/astria-cli-go/modules/cli/cmd"
+	"github.com/astriaorg/astria-cli-go/modules/cli/cmd/devrunner/config"
+	"github.com/astriaorg/astria-cli-go/modules/cli/internal/sequencer"
+	"github.com/astriaorg/astria-cli-go/modules/cli/internal/ui"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+// verifyCmd represents the verify command
+var verifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Verify the commitments of sequencer blocks.",
+}
+
+// verifyRollupTxsCmd represents the `verify rollup-txs` command
+var verifyRollupTxsCmd = &cobra.Command{
+	Use:   "rollup-txs [height] [rollup-name]",
+	Short: "Verify that a rollup's transactions in a block were not dropped or reordered.",
+	Long: `Get the sequencer block at a height filtered to a rollup from the sequencer's
+gRPC endpoint and verify it the way conductor does. The rollup IDs root and
+the root of the rollup's transactions are recomputed, and the Merkle proofs
+are checked against the block's data hash. The rollup can also be given as a
+0x prefixed hex ID.
+
+Each check is listed with its result. The command fails if any check finds a
+mismatch.
+
+The gRPC URL is read from the devrunner's networks-config.toml of --instance,
+for the network given by --network, unless --sequencer-grpc-url is set.`,
+	Args: cobra.ExactArgs(2),
+	Run:  verifyRollupTxsCmdHandler,
+}
+
+func verifyRollupTxsCmdHandler(c *cobra.Command, args []string) {
+	flagHandler := cmd.CreateCliFlagHandler(c, cmd.EnvPrefix)
+
+	printJSON := flagHandler.GetValue("json") == "true"
+
+	height, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		log.WithError(err).Error("Error parsing height to uint64")
+		panic(err)
+	}
+
+	grpcURL := flagHandler.GetValue("sequencer-grpc-url")
+	if grpcURL == "" {
+		grpcURL = sequencerGRPCURL(flagHandler.GetValue("instance"), flagHandler.GetValue("network"))
+	}
+
+	opts := sequencer.VerifyRollupTxsOpts{
+		SequencerGRPCURL: grpcURL,
+		Height:           height,
+		Rollup:           args[1],
+	}
+	result, err := sequencer.VerifyRollupTxs(opts)
+	if err != nil {
+		log.WithError(err).Error("Error verifying rollup transactions")
+		panic(err)
+	}
+
+	printer := ui.ResultsPrinter{
+		Data:      result,
+		PrintJSON: printJSON,
+	}
+	printer.Render()
+
+	if !result.Verified {
+		err := errors.New("rollup transactions failed verification")
+		log.WithError(err).Errorf("Block %d does not match its commitments for rollup %s", height, result.RollupID)
+		panic(err)
+	}
+}
+
+func init() {
+	SequencerCmd.AddCommand(verifyCmd)
+
+	verifyCmd.AddCommand(verifyRollupTxsCmd)
+	flagHandler := cmd.CreateCliFlagHandler(verifyRollupTxsCmd, cmd.EnvPrefix)
+	flagHandler.BindStringFlag("network", cmd.DefaultTargetNetwork, "The devrunner network to read the sequencer gRPC URL from.")
+	flagHandler.BindStringFlag("instance", config.DefaultInstanceName, "The devrunner instance to read the networks config from.")
+	flagHandler.BindStringFlag("sequencer-grpc-url", "", "The URL of the sequencer's gRPC endpoint. Overrides the URL from the networks config.")
+	flagHandler.BindBoolFlag("json", false, "Output the verification results in JSON format.")
+}
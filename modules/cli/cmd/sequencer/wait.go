@@ -0,0 +1,53 @@
+package sequencer
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/astriaorg/astria-cli-go/modules/cli/cmd"
+	"github.com/astriaorg/astria-cli-go/modules/go-sequencer-client/client"
+	log "github.com/sirupsen/logrus"
+)
+
+// bindWaitFlags binds the flags that configure how long a transaction
+// command waits for its transaction after broadcasting it.
+func bindWaitFlags(flagHandler *cmd.CliFlagHandler) {
+	flagHandler.BindStringFlag("wait", string(client.WaitCommit), "What to wait for after broadcasting the transaction: commit (included in a block), sync (accepted into the mempool), or none.")
+	flagHandler.BindStringFlag("wait-timeout", client.DefaultWaitTimeout.String(), "The maximum time to wait for the transaction to be committed, eg. 30s or 2m.")
+	flagHandler.BindStringFlag("confirmations", "0", "The number of blocks to wait for after the block including the transaction. Only used with --wait=commit.")
+	flagHandler.BindBoolFlag("async", false, "If true, the function will return immediately. If false, the function will wait for the transaction to be seen on the network.")
+	if err := flagHandler.Cmd.Flags().MarkDeprecated("async", "use --wait=none instead"); err != nil {
+		log.WithError(err).Fatal("Error marking async flag as deprecated")
+	}
+	flagHandler.Cmd.MarkFlagsMutuallyExclusive("wait", "async")
+}
+
+// waitOptionsFromFlags returns the wait options set by the flags bound with
+// bindWaitFlags.
+func waitOptionsFromFlags(flagHandler *cmd.CliFlagHandler) client.WaitOptions {
+	mode, err := client.ParseWaitMode(flagHandler.GetValue("wait"))
+	if err != nil {
+		log.WithError(err).Error("Error parsing wait mode")
+		panic(err)
+	}
+	if flagHandler.GetValue("async") == "true" {
+		mode = client.WaitNone
+	}
+
+	timeout, err := time.ParseDuration(flagHandler.GetValue("wait-timeout"))
+	if err != nil {
+		log.WithError(err).Error("Error parsing wait-timeout to duration")
+		panic(err)
+	}
+	confirmations, err := strconv.ParseInt(flagHandler.GetValue("confirmations"), 10, 64)
+	if err != nil {
+		log.WithError(err).Error("Error parsing confirmations to int64")
+		panic(err)
+	}
+
+	return client.WaitOptions{
+		Mode:          mode,
+		Timeout:       timeout,
+		Confirmations: confirmations,
+	}
+}
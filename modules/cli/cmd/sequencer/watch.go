@@ -0,0 +1,119 @@
+package sequencer
+
+import (
+	"context"
+	"os/signal"
+	"syscall"
+
+	"github.com/astriaorg/astria-cli-go/modules/bech32m"
+	"github.com/astriaorg/astria-cli-go/modules/cli/cmd"
+	"github.com/astriaorg/astria-cli-go/modules/cli/internal/sequencer"
+	"github.com/astriaorg/astria-cli-go/modules/cli/internal/ui"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+// watchCmd represents the watch command
+var watchCmd = &cobra.Command{
+	Use:   "watch",
+	Short: "Stream blocks or transactions from the sequencer as they happen.",
+	Long: `Stream blocks or transactions from the sequencer over its websocket as they
+are committed, until interrupted. The connection is re-established
+automatically if it drops. Use --json for newline delimited JSON output.`,
+}
+
+// watchBlocksCmd represents the `watch blocks` command
+var watchBlocksCmd = &cobra.Command{
+	Use:   "blocks",
+	Short: "Stream new blocks.",
+	Args:  cobra.NoArgs,
+	Run:   watchBlocksCmdHandler,
+}
+
+func watchBlocksCmdHandler(c *cobra.Command, _ []string) {
+	opts, printer := watchOptsFromFlags(c)
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	err := sequencer.WatchBlocks(ctx, opts, func(block *sequencer.WatchBlockResponse) {
+		printer.Print(block)
+	})
+	if err != nil {
+		log.WithError(err).Error("Error watching blocks")
+		panic(err)
+	}
+}
+
+// watchTxsCmd represents the `watch txs` command
+var watchTxsCmd = &cobra.Command{
+	Use:   "txs [--address <address>] [--action <action>]",
+	Short: "Stream executed transactions.",
+	Long: `Stream executed transactions with their signer, result code and action types.
+Use --address to only show transactions signed by or referencing an address,
+and --action to only show transactions containing an action type, ie.
+"transfer" or "rollup_data_submission".`,
+	Args: cobra.NoArgs,
+	Run:  watchTxsCmdHandler,
+}
+
+func watchTxsCmdHandler(c *cobra.Command, _ []string) {
+	opts, printer := watchOptsFromFlags(c)
+
+	flagHandler := cmd.CreateCliFlagHandler(c, cmd.EnvPrefix)
+	opts.Address = flagHandler.GetValue("address")
+	opts.ActionType = flagHandler.GetValue("action")
+	if opts.Address != "" {
+		if err := bech32m.Validate(opts.Address); err != nil {
+			log.WithError(err).Errorf("Invalid address: %s", opts.Address)
+			panic(err)
+		}
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	err := sequencer.WatchTxs(ctx, opts, func(tx *sequencer.WatchTxResponse) {
+		printer.Print(tx)
+	})
+	if err != nil {
+		log.WithError(err).Error("Error watching transactions")
+		panic(err)
+	}
+}
+
+// watchOptsFromFlags builds the WatchOpts and StreamPrinter shared by the
+// watch commands.
+func watchOptsFromFlags(c *cobra.Command) (sequencer.WatchOpts, *ui.StreamPrinter) {
+	flagHandler := cmd.CreateCliFlagHandlerWithUseConfigFlag(c, cmd.EnvPrefix, "network")
+	networkConfig := GetNetworkConfigFromFlags(flagHandler)
+	flagHandler.SetConfig(networkConfig)
+
+	printJSON := flagHandler.GetValue("json") == "true"
+	sequencerURL := flagHandler.GetValue("sequencer-url")
+	sequencerURL = AddPortToURL(sequencerURL)
+
+	opts := sequencer.WatchOpts{
+		AddressPrefix: DefaultAddressPrefix,
+		SequencerURL:  sequencerURL,
+	}
+	return opts, &ui.StreamPrinter{PrintJSON: printJSON}
+}
+
+func init() {
+	SequencerCmd.AddCommand(watchCmd)
+
+	watchCmd.AddCommand(watchBlocksCmd)
+	wbfh := cmd.CreateCliFlagHandler(watchBlocksCmd, cmd.EnvPrefix)
+	wbfh.BindStringFlag("network", cmd.DefaultTargetNetwork, "Configure the values to target a specific network.")
+	wbfh.BindStringPFlag("sequencer-url", "u", DefaultSequencerURL, "The URL of the sequencer.")
+	wbfh.BindBoolFlag("json", false, "Output as newline delimited JSON.")
+
+	watchCmd.AddCommand(watchTxsCmd)
+	wtfh := cmd.CreateCliFlagHandler(watchTxsCmd, cmd.EnvPrefix)
+	wtfh.BindStringFlag("network", cmd.DefaultTargetNetwork, "Configure the values to target a specific network.")
+	wtfh.BindStringPFlag("sequencer-url", "u", DefaultSequencerURL, "The URL of the sequencer.")
+	wtfh.BindBoolFlag("json", false, "Output as newline delimited JSON.")
+	wtfh.BindStringFlag("address", "", "Only show transactions signed by or referencing this address.")
+	wtfh.BindStringFlag("action", "", "Only show transactions containing an action of this type, ie. \"transfer\".")
+}
@@ -0,0 +1,99 @@
+package asset
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+// Units describes the base and display units of an asset. Amounts are
+// transferred in base units, ie. "utia", and shown in display units, ie.
+// "TIA", where one display unit is 10^Decimals base units.
+type Units struct {
+	// Denom is the denom of the base unit
+	Denom string
+	// Display is the name of the display unit. Amounts cannot be given in
+	// display units if it is empty.
+	Display string
+	// Decimals is the number of decimal places of the display unit
+	Decimals uint32
+}
+
+// amountPattern splits an amount into its number and unit.
+var amountPattern = regexp.MustCompile(`^([0-9]*\.?[0-9]*)\s*(\S*)$`)
+
+// BaseDenom returns the base denom of a trace-prefixed denom, the last
+// segment of its path, ie. "utia" for "transfer/channel-0/utia".
+func BaseDenom(denom string) string {
+	return denom[strings.LastIndex(denom, "/")+1:]
+}
+
+// ParseAmount parses an amount of the asset described by units and returns
+// it in base units. The amount is either an integer number of base units,
+// with or without the base denom as a suffix, ie. "1500000" or
+// "1500000utia", or a decimal number of display units with the display unit
+// as a suffix, ie. "1.5TIA". Amounts with more decimal places than the unit
+// allows are rejected.
+func ParseAmount(s string, units Units) (*big.Int, error) {
+	m := amountPattern.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil || strings.Trim(m[1], ".") == "" {
+		return nil, fmt.Errorf("invalid amount %q", s)
+	}
+	number, unit := m[1], m[2]
+
+	switch {
+	case unit == "":
+		return parseDecimal(number, 0, units.Denom)
+	case unit == units.Denom || unit == BaseDenom(units.Denom):
+		return parseDecimal(number, 0, unit)
+	case units.Display != "" && strings.EqualFold(unit, units.Display):
+		return parseDecimal(number, units.Decimals, units.Display)
+	case units.Display == "":
+		return nil, fmt.Errorf("unit %s does not match asset %s, and no display unit is configured for it", unit, units.Denom)
+	default:
+		return nil, fmt.Errorf("unit %s does not match asset %s, expected %s or %s", unit, units.Denom, BaseDenom(units.Denom), units.Display)
+	}
+}
+
+// parseDecimal parses a decimal number with at most decimals decimal places
+// and returns it multiplied by 10^decimals.
+func parseDecimal(number string, decimals uint32, unit string) (*big.Int, error) {
+	whole, frac, _ := strings.Cut(number, ".")
+	frac = strings.TrimRight(frac, "0")
+	if uint32(len(frac)) > decimals {
+		if decimals == 0 {
+			return nil, fmt.Errorf("amount %s has too much precision, amounts in %s must be whole numbers", number, unit)
+		}
+		return nil, fmt.Errorf("amount %s has too much precision, %s has %d decimal places", number, unit, decimals)
+	}
+	frac += strings.Repeat("0", int(decimals)-len(frac))
+
+	amount, ok := new(big.Int).SetString(whole+frac, 10)
+	if !ok {
+		// whole and frac are both empty, ie. for ".0" in base units
+		amount = big.NewInt(0)
+	}
+	return amount, nil
+}
+
+// FormatAmount formats an amount in base units as a decimal number of
+// display units followed by the display unit, ie. "1.5 TIA". If units has no
+// display unit, the amount is formatted in base units.
+func FormatAmount(amount *big.Int, units Units) string {
+	if units.Display == "" {
+		return fmt.Sprintf("%s %s", amount, units.Denom)
+	}
+
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(units.Decimals)), nil)
+	whole, frac := new(big.Int).QuoRem(new(big.Int).Abs(amount), scale, new(big.Int))
+	number := whole.String()
+	if frac.Sign() != 0 {
+		fracStr := fmt.Sprintf("%0*s", units.Decimals, frac.String())
+		number += "." + strings.TrimRight(fracStr, "0")
+	}
+	if amount.Sign() < 0 {
+		number = "-" + number
+	}
+	return fmt.Sprintf("%s %s", number, units.Display)
+}
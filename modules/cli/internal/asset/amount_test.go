@@ -0,0 +1,75 @@
+package asset
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAmount(t *testing.T) {
+	tia := Units{Denom: "transfer/channel-0/utia", Display: "TIA", Decimals: 6}
+	tests := []struct {
+		input    string
+		units    Units
+		expected string
+	}{
+		{"1500000", tia, "1500000"},
+		{"1500000utia", tia, "1500000"},
+		{"1500000transfer/channel-0/utia", tia, "1500000"},
+		{"1.5TIA", tia, "1500000"},
+		{"1.5 tia", tia, "1500000"},
+		{"0.000001TIA", tia, "1"},
+		{".5TIA", tia, "500000"},
+		{"2TIA", tia, "2000000"},
+		{"1.500000TIA", tia, "1500000"},
+		{"100", Units{Denom: "nria"}, "100"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			amount, err := ParseAmount(tt.input, tt.units)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, amount.String())
+		})
+	}
+
+	invalid := []struct {
+		input string
+		units Units
+	}{
+		{"0.0000001TIA", tia},
+		{"1.5utia", tia},
+		{"1.5", tia},
+		{"1.5ntia", tia},
+		{"1.5TIA", Units{Denom: "utia"}},
+		{"-1", tia},
+		{"TIA", tia},
+		{".", tia},
+		{"1e6", tia},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.input, func(t *testing.T) {
+			_, err := ParseAmount(tt.input, tt.units)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestFormatAmount(t *testing.T) {
+	tia := Units{Denom: "utia", Display: "TIA", Decimals: 6}
+	assert.Equal(t, "1.5 TIA", FormatAmount(big.NewInt(1500000), tia))
+	assert.Equal(t, "0.000001 TIA", FormatAmount(big.NewInt(1), tia))
+	assert.Equal(t, "2 TIA", FormatAmount(big.NewInt(2000000), tia))
+	assert.Equal(t, "0 TIA", FormatAmount(big.NewInt(0), tia))
+	assert.Equal(t, "100 nria", FormatAmount(big.NewInt(100), Units{Denom: "nria"}))
+
+	amount, err := ParseAmount(FormatAmount(big.NewInt(123456789), tia), tia)
+	require.NoError(t, err)
+	assert.Equal(t, int64(123456789), amount.Int64(), "formatted amounts should parse back to the same amount")
+}
+
+func TestBaseDenom(t *testing.T) {
+	assert.Equal(t, "utia", BaseDenom("transfer/channel-0/utia"))
+	assert.Equal(t, "ntia", BaseDenom("ntia"))
+}
@@ -0,0 +1,192 @@
+// Package asset converts between Astria asset denoms and asset IDs.
+//
+// The ID of an asset is the SHA-256 hash of its trace-prefixed denom, ie.
+// "ntia" or "transfer/channel-0/utia". The sequencer also refers to assets by
+// their IBC-prefixed denom, "ibc/" followed by the hex encoded ID, which does
+// not reveal the trace-prefixed denom. A Registry resolves IDs back to
+// trace-prefixed denoms through the sequencer's denom query, caching the
+// results locally.
+//
+// Amounts are transferred in an asset's base unit, ie. "utia". ParseAmount
+// and FormatAmount convert between base units and a display unit, ie. "TIA".
+package asset
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/astriaorg/astria-cli-go/modules/go-sequencer-client/client"
+	log "github.com/sirupsen/logrus"
+)
+
+// ibcPrefix is the prefix of IBC-prefixed denoms.
+const ibcPrefix = "ibc/"
+
+// ID is the ID of an asset.
+type ID [sha256.Size]byte
+
+// String returns the hex encoded ID.
+func (id ID) String() string {
+	return hex.EncodeToString(id[:])
+}
+
+// IBCPrefixed returns the IBC-prefixed denom of the asset, "ibc/" followed by
+// the hex encoded ID.
+func (id ID) IBCPrefixed() string {
+	return ibcPrefix + id.String()
+}
+
+// IDFromDenom returns the ID of the asset with the given denom. The denom can
+// be trace-prefixed or IBC-prefixed.
+func IDFromDenom(denom string) ID {
+	if id, ok := parseIBCPrefixed(denom); ok {
+		return id
+	}
+	return sha256.Sum256([]byte(denom))
+}
+
+// IsIBCPrefixed returns true if denom is an IBC-prefixed denom, which must
+// be resolved to learn the asset's trace-prefixed denom.
+func IsIBCPrefixed(denom string) bool {
+	_, ok := parseIBCPrefixed(denom)
+	return ok
+}
+
+// ParseID parses an asset ID given as an IBC-prefixed denom, or as hex with
+// an optional 0x prefix. It returns false if s is not an asset ID.
+func ParseID(s string) (ID, bool) {
+	if id, ok := parseIBCPrefixed(s); ok {
+		return id, true
+	}
+	return parseHexID(strings.TrimPrefix(s, "0x"))
+}
+
+func parseIBCPrefixed(denom string) (ID, bool) {
+	if !strings.HasPrefix(denom, ibcPrefix) {
+		return ID{}, false
+	}
+	return parseHexID(strings.TrimPrefix(denom, ibcPrefix))
+}
+
+func parseHexID(s string) (ID, bool) {
+	var id ID
+	if len(s) != hex.EncodedLen(len(id)) {
+		return ID{}, false
+	}
+	if _, err := hex.Decode(id[:], []byte(s)); err != nil {
+		return ID{}, false
+	}
+	return id, true
+}
+
+// Registry resolves asset IDs to trace-prefixed denoms. Resolved denoms are
+// cached in a JSON file, as an asset's ID never changes.
+type Registry struct {
+	client    *client.Client
+	cachePath string
+
+	mu     sync.Mutex
+	denoms map[string]string
+}
+
+// NewRegistry returns a registry that resolves IDs with c and caches them at
+// cachePath. Nothing is cached if cachePath is empty.
+func NewRegistry(c *client.Client, cachePath string) *Registry {
+	r := &Registry{
+		client:    c,
+		cachePath: cachePath,
+		denoms:    map[string]string{},
+	}
+	if cachePath == "" {
+		return r
+	}
+	data, err := os.ReadFile(cachePath)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			log.WithError(err).Warn("Error reading asset cache")
+		}
+		return r
+	}
+	if err := json.Unmarshal(data, &r.denoms); err != nil {
+		log.WithError(err).Warn("Ignoring invalid asset cache")
+		r.denoms = map[string]string{}
+	}
+	return r
+}
+
+// Resolve returns the trace-prefixed denom of the asset with the given ID.
+// The second return value is true if the denom was found in the cache.
+func (r *Registry) Resolve(ctx context.Context, id ID) (string, bool, error) {
+	r.mu.Lock()
+	denom, ok := r.denoms[id.String()]
+	r.mu.Unlock()
+	if ok {
+		return denom, true, nil
+	}
+
+	resp, err := r.client.GetDenom(ctx, id.String())
+	if err != nil {
+		return "", false, fmt.Errorf("failed to resolve asset %s: %w", id, err)
+	}
+	if IDFromDenom(resp.Denom) != id {
+		return "", false, fmt.Errorf("sequencer returned denom %s which does not match asset %s", resp.Denom, id)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.denoms[id.String()] = resp.Denom
+	if err := r.save(); err != nil {
+		log.WithError(err).Warn("Error writing asset cache")
+	}
+	return resp.Denom, false, nil
+}
+
+// Denom returns the trace-prefixed denom for denom, resolving it if it is
+// IBC-prefixed. If it cannot be resolved, denom is returned unchanged along
+// with the error.
+func (r *Registry) Denom(ctx context.Context, denom string) (string, error) {
+	id, ok := parseIBCPrefixed(denom)
+	if !ok {
+		return denom, nil
+	}
+	resolved, _, err := r.Resolve(ctx, id)
+	if err != nil {
+		return denom, err
+	}
+	return resolved, nil
+}
+
+// save writes the cache, replacing the existing file atomically.
+func (r *Registry) save() error {
+	if r.cachePath == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(r.denoms, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.cachePath), 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(r.cachePath), filepath.Base(r.cachePath)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), r.cachePath)
+}
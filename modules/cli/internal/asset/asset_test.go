@@ -0,0 +1,127 @@
+package asset
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	assetproto "buf.build/gen/go/astria/protocol-apis/protocolbuffers/go/astria/protocol/asset/v1"
+	"github.com/astriaorg/astria-cli-go/modules/go-sequencer-client/client"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// newTestDenomServer serves the denom query for the given denoms, keyed by
+// hex encoded asset ID, and counts the queries.
+func newTestDenomServer(t *testing.T, denoms map[string]string) (*httptest.Server, func() int) {
+	var mu sync.Mutex
+	queries := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req rpctypes.RPCRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.Equal(t, "abci_query", req.Method)
+
+		var params struct {
+			Path string `json:"path"`
+		}
+		require.NoError(t, json.Unmarshal(req.Params, &params))
+		mu.Lock()
+		queries++
+		mu.Unlock()
+
+		result := &coretypes.ResultABCIQuery{Response: abcitypes.ResponseQuery{Code: 1, Log: "asset not found"}}
+		if denom, ok := denoms[strings.TrimPrefix(params.Path, "asset/denom/")]; ok {
+			value, err := proto.Marshal(&assetproto.DenomResponse{Height: 3, Denom: denom})
+			require.NoError(t, err)
+			result = &coretypes.ResultABCIQuery{Response: abcitypes.ResponseQuery{Value: value}}
+		}
+		data, err := cmtjson.Marshal(result)
+		require.NoError(t, err)
+		require.NoError(t, json.NewEncoder(w).Encode(rpctypes.RPCResponse{JSONRPC: "2.0", ID: req.ID, Result: data}))
+	}))
+	return server, func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		return queries
+	}
+}
+
+func TestIDFromDenom(t *testing.T) {
+	hash := sha256.Sum256([]byte("transfer/channel-0/utia"))
+	id := IDFromDenom("transfer/channel-0/utia")
+	assert.Equal(t, hex.EncodeToString(hash[:]), id.String())
+	assert.Equal(t, "ibc/"+hex.EncodeToString(hash[:]), id.IBCPrefixed())
+	assert.Equal(t, id, IDFromDenom(id.IBCPrefixed()), "an IBC-prefixed denom should contain its ID")
+	assert.NotEqual(t, IDFromDenom("ntia"), IDFromDenom("nria"))
+
+	assert.True(t, IsIBCPrefixed(id.IBCPrefixed()))
+	assert.False(t, IsIBCPrefixed("ibc/channel-0/utia"), "a trace-prefixed denom starting with ibc should not be IBC-prefixed")
+}
+
+func TestParseID(t *testing.T) {
+	id := IDFromDenom("ntia")
+	for _, s := range []string{id.String(), "0x" + id.String(), id.IBCPrefixed()} {
+		parsed, ok := ParseID(s)
+		assert.True(t, ok, s)
+		assert.Equal(t, id, parsed, s)
+	}
+	for _, s := range []string{"ntia", "ibc/channel-0/utia", id.String()[:10], strings.Repeat("zz", 32)} {
+		_, ok := ParseID(s)
+		assert.False(t, ok, s)
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	id := IDFromDenom("transfer/channel-0/utia")
+	bad := IDFromDenom("bad")
+	server, queries := newTestDenomServer(t, map[string]string{
+		id.String():  "transfer/channel-0/utia",
+		bad.String(): "not-bad",
+	})
+	defer server.Close()
+	c, err := client.NewClient(server.URL)
+	require.NoError(t, err)
+	cachePath := filepath.Join(t.TempDir(), "asset-cache.json")
+	ctx := context.Background()
+
+	registry := NewRegistry(c, cachePath)
+	denom, cached, err := registry.Resolve(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, "transfer/channel-0/utia", denom)
+	assert.False(t, cached)
+
+	readable, err := registry.Denom(ctx, id.IBCPrefixed())
+	require.NoError(t, err)
+	assert.Equal(t, "transfer/channel-0/utia", readable)
+	plain, err := registry.Denom(ctx, "ntia")
+	require.NoError(t, err)
+	assert.Equal(t, "ntia", plain, "trace-prefixed denoms should not be resolved")
+	assert.Equal(t, 1, queries(), "resolved denoms should be cached")
+
+	// a new registry reads the cache written by the first one
+	denom, cached, err = NewRegistry(c, cachePath).Resolve(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, "transfer/channel-0/utia", denom)
+	assert.True(t, cached)
+	assert.Equal(t, 1, queries())
+
+	_, _, err = registry.Resolve(ctx, bad)
+	assert.ErrorContains(t, err, "does not match", "denoms that do not hash to the ID should be rejected")
+
+	unknown := IDFromDenom("unknown")
+	readable, err = registry.Denom(ctx, unknown.IBCPrefixed())
+	assert.Error(t, err)
+	assert.Equal(t, unknown.IBCPrefixed(), readable, "unresolved denoms should be returned unchanged")
+}
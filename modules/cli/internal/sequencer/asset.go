@@ -0,0 +1,87 @@
+package sequencer
+
+import (
+	"context"
+	"time"
+
+	"github.com/astriaorg/astria-cli-go/modules/cli/internal/asset"
+	"github.com/astriaorg/astria-cli-go/modules/go-sequencer-client/client"
+	log "github.com/sirupsen/logrus"
+)
+
+// Sources of the denom in an AssetInfoResponse.
+const (
+	// AssetSourceDenom means the asset was given by its denom
+	AssetSourceDenom = "denom"
+	// AssetSourceCache means the denom was found in the local asset cache
+	AssetSourceCache = "cache"
+	// AssetSourceSequencer means the denom was resolved by the sequencer
+	AssetSourceSequencer = "sequencer"
+)
+
+// GetAssetInfo returns the denom and ID of the asset given by opts.Asset,
+// which is either a trace-prefixed denom or an asset ID. IDs are resolved to
+// their denom through the sequencer, denoms are not looked up.
+func GetAssetInfo(opts AssetInfoOpts) (*AssetInfoResponse, error) {
+	id, isID := asset.ParseID(opts.Asset)
+	if !isID {
+		id = asset.IDFromDenom(opts.Asset)
+		return &AssetInfoResponse{
+			Denom:       opts.Asset,
+			ID:          id.String(),
+			IBCPrefixed: id.IBCPrefixed(),
+			Source:      AssetSourceDenom,
+		}, nil
+	}
+
+	log.Debug("Creating CometBFT client with url: ", opts.SequencerURL)
+	c, err := client.NewClient(opts.SequencerURL)
+	if err != nil {
+		log.WithError(err).Error("Error creating sequencer client")
+		return &AssetInfoResponse{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	denom, cached, err := asset.NewRegistry(c, opts.AssetCachePath).Resolve(ctx, id)
+	if err != nil {
+		log.WithError(err).Error("Error resolving asset")
+		return &AssetInfoResponse{}, err
+	}
+	source := AssetSourceSequencer
+	if cached {
+		source = AssetSourceCache
+	}
+	return &AssetInfoResponse{
+		Denom:       denom,
+		ID:          id.String(),
+		IBCPrefixed: id.IBCPrefixed(),
+		Source:      source,
+	}, nil
+}
+
+// ResolveBalanceDenoms replaces the IBC-prefixed denoms of balances with
+// their trace-prefixed denoms. Denoms that cannot be resolved are left
+// unchanged.
+func ResolveBalanceDenoms(balances *BalancesResponse, sequencerURL string, assetCachePath string) {
+	log.Debug("Creating CometBFT client with url: ", sequencerURL)
+	c, err := client.NewClient(sequencerURL)
+	if err != nil {
+		log.WithError(err).Warn("Error creating sequencer client, showing denoms as reported")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	registry := asset.NewRegistry(c, assetCachePath)
+	for _, b := range *balances {
+		denom, err := registry.Denom(ctx, b.Denom)
+		if err != nil {
+			log.WithError(err).Warnf("Error resolving denom %s", b.Denom)
+			continue
+		}
+		b.Denom = denom
+	}
+}
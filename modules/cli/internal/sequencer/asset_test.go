@@ -0,0 +1,60 @@
+package sequencer
+
+import (
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	assetproto "buf.build/gen/go/astria/protocol-apis/protocolbuffers/go/astria/protocol/asset/v1"
+	"github.com/astriaorg/astria-cli-go/modules/cli/internal/asset"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestGetAssetInfo(t *testing.T) {
+	id := asset.IDFromDenom("transfer/channel-0/utia")
+	server := newTestQueryServer(t, map[string]proto.Message{
+		"asset/denom/" + id.String(): &assetproto.DenomResponse{Height: 3, Denom: "transfer/channel-0/utia"},
+	}, nil)
+	defer server.Close()
+	cachePath := filepath.Join(t.TempDir(), "asset-cache.json")
+
+	fromDenom, err := GetAssetInfo(AssetInfoOpts{Asset: "transfer/channel-0/utia"})
+	require.NoError(t, err, "denoms should not need the sequencer")
+	assert.Equal(t, id.String(), fromDenom.ID)
+	assert.Equal(t, id.IBCPrefixed(), fromDenom.IBCPrefixed)
+	assert.Equal(t, AssetSourceDenom, fromDenom.Source)
+
+	fromID, err := GetAssetInfo(AssetInfoOpts{SequencerURL: server.URL, AssetCachePath: cachePath, Asset: id.IBCPrefixed()})
+	require.NoError(t, err)
+	assert.Equal(t, "transfer/channel-0/utia", fromID.Denom)
+	assert.Equal(t, AssetSourceSequencer, fromID.Source)
+
+	cached, err := GetAssetInfo(AssetInfoOpts{SequencerURL: server.URL, AssetCachePath: cachePath, Asset: "0x" + id.String()})
+	require.NoError(t, err)
+	assert.Equal(t, "transfer/channel-0/utia", cached.Denom)
+	assert.Equal(t, AssetSourceCache, cached.Source)
+
+	_, err = GetAssetInfo(AssetInfoOpts{SequencerURL: server.URL, AssetCachePath: cachePath, Asset: asset.IDFromDenom("unknown").String()})
+	assert.Error(t, err)
+}
+
+func TestResolveBalanceDenoms(t *testing.T) {
+	id := asset.IDFromDenom("transfer/channel-0/utia")
+	server := newTestQueryServer(t, map[string]proto.Message{
+		"asset/denom/" + id.String(): &assetproto.DenomResponse{Height: 3, Denom: "transfer/channel-0/utia"},
+	}, nil)
+	defer server.Close()
+
+	unknown := asset.IDFromDenom("unknown").IBCPrefixed()
+	balances := &BalancesResponse{
+		{Denom: "ntia", Balance: big.NewInt(1)},
+		{Denom: id.IBCPrefixed(), Balance: big.NewInt(2)},
+		{Denom: unknown, Balance: big.NewInt(3)},
+	}
+	ResolveBalanceDenoms(balances, server.URL, filepath.Join(t.TempDir(), "asset-cache.json"))
+	assert.Equal(t, "ntia", (*balances)[0].Denom)
+	assert.Equal(t, "transfer/channel-0/utia", (*balances)[1].Denom)
+	assert.Equal(t, unknown, (*balances)[2].Denom, "unresolved denoms should be shown as reported")
+}
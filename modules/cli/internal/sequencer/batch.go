@@ -0,0 +1,630 @@
+package sequencer
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	primproto "buf.build/gen/go/astria/primitives/protocolbuffers/go/astria/primitive/v1"
+	txproto "buf.build/gen/go/astria/protocol-apis/protocolbuffers/go/astria/protocol/transaction/v1"
+	"github.com/astriaorg/astria-cli-go/modules/bech32m"
+	"github.com/astriaorg/astria-cli-go/modules/go-sequencer-client/client"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// DefaultBatchConcurrency is the number of batch transfers broadcast at
+	// once.
+	DefaultBatchConcurrency = 4
+	// DefaultBatchRetries is the number of times broadcasting a batch
+	// transfer is retried after a transient failure.
+	DefaultBatchRetries = 3
+)
+
+// Statuses of a row of a batch transfer.
+const (
+	// BatchStatusCommitted means the transfer was included in a block.
+	BatchStatusCommitted = "committed"
+	// BatchStatusBroadcast means the transfer was accepted by the sequencer,
+	// but its inclusion was not waited for.
+	BatchStatusBroadcast = "broadcast"
+	// BatchStatusPending means the transfer was accepted by the sequencer,
+	// but was not included before the wait timeout.
+	BatchStatusPending = "pending"
+	// BatchStatusFailed means the transfer was rejected or could not be
+	// broadcast.
+	BatchStatusFailed = "failed"
+	// BatchStatusSkipped means the transfer was not attempted because an
+	// earlier transfer failed.
+	BatchStatusSkipped = "skipped"
+	// BatchStatusUnknown means the nonce of the transfer was used, but its
+	// transaction was not found, so it is unknown whether it was committed.
+	// It is not sent again and must be checked manually.
+	BatchStatusUnknown = "unknown"
+)
+
+// errBatchTxRejected is wrapped by the errors of batch transfers that failed
+// CheckTx, so they never entered the sequencer's mempool.
+var errBatchTxRejected = errors.New("rejected by the sequencer")
+
+// errBatchNonceRejected is wrapped by the errors of batch transfers that
+// failed CheckTx because of their nonce.
+var errBatchNonceRejected = fmt.Errorf("%w for its nonce", errBatchTxRejected)
+
+// batchRetryInterval is the wait before the first retry of a transient
+// failure, doubled for every further retry.
+var batchRetryInterval = 500 * time.Millisecond
+
+// LoadBatchTransferFile reads the transfers of a batch from a CSV file with
+// the columns address, amount and an optional asset. A header row starting
+// with "address" and lines starting with "#" are skipped. Every row is
+// validated before any is returned.
+func LoadBatchTransferFile(path string) ([]*BatchTransferRow, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.Comment = '#'
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	rows := []*BatchTransferRow{}
+	for {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := r.FieldPos(0)
+		if len(rows) == 0 && strings.EqualFold(strings.TrimSpace(record[0]), "address") {
+			continue
+		}
+		if len(record) < 2 || len(record) > 3 {
+			return nil, fmt.Errorf("line %d: expected address, amount and optional asset columns, got %d columns", line, len(record))
+		}
+
+		row := &BatchTransferRow{
+			Row:     len(rows) + 1,
+			Address: strings.TrimSpace(record[0]),
+			Amount:  strings.TrimSpace(record[1]),
+		}
+		if len(record) == 3 {
+			row.Asset = strings.TrimSpace(record[2])
+		}
+		if _, err := requiredAddress("address", row.Address); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		if _, err := requiredAmount(row.Amount); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		rows = append(rows, row)
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("%s does not contain any transfers", path)
+	}
+	return rows, nil
+}
+
+// BatchTransfer sends a transfer for each of opts.Rows. Nonces are reserved
+// once and assigned sequentially to the transfers, which are then broadcast
+// with bounded concurrency. Transient broadcast failures are retried. If a
+// transfer fails, the transfers that were not started yet are skipped, as
+// their nonces can no longer be used in order.
+//
+// If opts.ResultsPath is set, the results and signed transactions are written
+// to it after every transfer. Running a batch again with an existing results
+// file resumes it: committed rows are not sent again, and the recorded
+// transactions of the other rows are reconciled with the sequencer. A
+// transaction whose nonce is still unused is broadcast again as is, so that
+// at most one copy of it can be executed. Rows are only signed again with a
+// new nonce if their transaction was never broadcast, was rejected, or failed
+// to execute.
+func BatchTransfer(opts BatchTransferOpts) (*BatchTransferResponse, error) {
+	log.Debug("Creating CometBFT client with url: ", opts.SequencerURL)
+	c, err := client.NewClient(opts.SequencerURL)
+	if err != nil {
+		log.WithError(err).Error("Error creating sequencer client")
+		return &BatchTransferResponse{}, err
+	}
+
+	signer := client.NewSigner(opts.FromKey)
+	addr, err := bech32m.EncodeFromBytes(opts.AddressPrefix, signer.Address())
+	if err != nil {
+		log.WithError(err).Error("Failed to encode address")
+		return &BatchTransferResponse{}, err
+	}
+
+	results := make([]*BatchTransferResult, len(opts.Rows))
+	for i, row := range opts.Rows {
+		asset := row.Asset
+		if asset == "" {
+			asset = opts.Asset
+		}
+		results[i] = &BatchTransferResult{
+			Row:     row.Row,
+			Address: row.Address,
+			Amount:  row.Amount,
+			Asset:   asset,
+			Status:  BatchStatusSkipped,
+		}
+	}
+	if err := resumeBatchResults(c, opts.ResultsPath, addr.String(), results); err != nil {
+		log.WithError(err).Error("Error resuming from results file")
+		return &BatchTransferResponse{}, err
+	}
+
+	// recorded transactions are broadcast again, and new nonces are reserved
+	// after theirs for the rows that still need to be signed
+	resend := []*BatchTransferResult{}
+	fresh := []*BatchTransferResult{}
+	var from uint32
+	for _, r := range results {
+		switch {
+		case r.Status == BatchStatusCommitted || r.Status == BatchStatusUnknown:
+		case r.SignedTx != "":
+			resend = append(resend, r)
+			if r.Nonce >= from {
+				from = r.Nonce + 1
+			}
+		default:
+			fresh = append(fresh, r)
+		}
+	}
+	res := &BatchTransferResponse{From: addr.String(), Results: results}
+	if len(resend)+len(fresh) == 0 {
+		log.Info("No transfers left to send")
+		return res, nil
+	}
+
+	nonceCtx, nonceCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer nonceCancel()
+	reservation, err := reserveNonceFrom(nonceCtx, c, opts.SequencerChainID, addr.String(), from, uint32(len(fresh)), opts.Wait, opts.UseNonceManager)
+	if err != nil {
+		log.WithError(err).Error("Error reserving nonces")
+		return &BatchTransferResponse{}, err
+	}
+	// the nonces are released if no transfer is sent, so that later
+	// transactions do not wait on them
+	sending := false
+	defer func() {
+		if sending {
+			return
+		}
+		if err := reservation.Release(reservation.Nonce); err != nil {
+			log.WithError(err).Warn("Error releasing nonces")
+		}
+	}()
+
+	// sign every transfer up front so their hashes and transactions are
+	// recorded even if broadcasting fails
+	signed := make(map[*BatchTransferResult]*txproto.Transaction, len(resend)+len(fresh))
+	for _, r := range resend {
+		signed[r], err = decodeBatchTx(r)
+		if err != nil {
+			return &BatchTransferResponse{}, err
+		}
+	}
+	for i, r := range fresh {
+		r.Nonce = reservation.Nonce + uint32(i)
+		r.Status = BatchStatusSkipped
+		r.Error = ""
+		signed[r], err = signBatchTransfer(signer, opts, r)
+		if err != nil {
+			return &BatchTransferResponse{}, err
+		}
+	}
+	if err := writeBatchResults(opts.ResultsPath, res); err != nil {
+		log.WithError(err).Error("Error writing results file")
+		return &BatchTransferResponse{}, err
+	}
+
+	// transfers are started in nonce order, so the ones that are skipped
+	// always have higher nonces than the ones that were attempted
+	send := append(resend, fresh...)
+	sort.Slice(send, func(i, j int) bool { return send[i].Nonce < send[j].Nonce })
+
+	concurrency := opts.Concurrency
+	if concurrency < 1 {
+		concurrency = DefaultBatchConcurrency
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sending = true
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	errs := make(map[*BatchTransferResult]error)
+	sem := make(chan struct{}, concurrency)
+	for _, r := range send {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(r *BatchTransferResult, tx *txproto.Transaction) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			status, err := sendBatchTransfer(ctx, c, tx, opts.Retries, opts.Wait)
+			mu.Lock()
+			defer mu.Unlock()
+			r.Status = status
+			r.Error = ""
+			errs[r] = err
+			if err != nil {
+				r.Error = err.Error()
+			}
+			if status == BatchStatusFailed {
+				log.WithError(err).Errorf("Transfer in row %d failed, skipping remaining transfers", r.Row)
+				cancel()
+			} else {
+				log.Debugf("Transfer in row %d %s", r.Row, status)
+			}
+			if err := writeBatchResults(opts.ResultsPath, res); err != nil {
+				log.WithError(err).Error("Error writing results file")
+			}
+		}(r, signed[r])
+	}
+	wg.Wait()
+
+	releaseBatchNonces(reservation, send, errs)
+
+	for _, r := range send {
+		switch err := errs[r]; {
+		case r.Status == BatchStatusSkipped:
+			// skipped transfers were never broadcast, so they are signed
+			// again with new nonces when resuming
+			r.Nonce, r.TxHash, r.SignedTx = 0, "", ""
+		case errors.Is(err, errBatchTxRejected), errors.Is(err, client.ErrTxFailed):
+			// the transaction can not be executed anymore, so the transfer
+			// is signed again with a new nonce when resuming
+			r.SignedTx = ""
+		}
+	}
+	if err := writeBatchResults(opts.ResultsPath, res); err != nil {
+		log.WithError(err).Error("Error writing results file")
+		return res, err
+	}
+	return res, nil
+}
+
+// signBatchTransfer signs the transfer of r with r.Nonce, and records the
+// hash and encoded signed transaction in r.
+func signBatchTransfer(signer *client.Signer, opts BatchTransferOpts, r *BatchTransferResult) (*txproto.Transaction, error) {
+	amount, err := requiredAmount(r.Amount)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := signer.SignTransaction(&txproto.TransactionBody{
+		Params: &txproto.TransactionParams{
+			ChainId: opts.SequencerChainID,
+			Nonce:   r.Nonce,
+		},
+		Actions: []*txproto.Action{TransferAction(TransferOpts{
+			ToAddress: &primproto.Address{Bech32M: r.Address},
+			Amount:    amount,
+			Asset:     r.Asset,
+			FeeAsset:  opts.FeeAsset,
+		})},
+	})
+	if err != nil {
+		log.WithError(err).Error("Error signing transaction")
+		return nil, err
+	}
+	r.TxHash, err = TxHash(tx)
+	if err != nil {
+		log.WithError(err).Error("Error hashing transaction")
+		return nil, err
+	}
+	data, err := proto.Marshal(tx)
+	if err != nil {
+		return nil, err
+	}
+	r.SignedTx = base64.StdEncoding.EncodeToString(data)
+	return tx, nil
+}
+
+// decodeBatchTx decodes the signed transaction recorded in r, and checks that
+// it matches the recorded hash.
+func decodeBatchTx(r *BatchTransferResult) (*txproto.Transaction, error) {
+	data, err := base64.StdEncoding.DecodeString(r.SignedTx)
+	if err != nil {
+		return nil, fmt.Errorf("row %d has an invalid signed transaction: %w", r.Row, err)
+	}
+	tx := &txproto.Transaction{}
+	if err := proto.Unmarshal(data, tx); err != nil {
+		return nil, fmt.Errorf("row %d has an invalid signed transaction: %w", r.Row, err)
+	}
+	hash, err := TxHash(tx)
+	if err != nil {
+		return nil, err
+	}
+	if hash != r.TxHash {
+		return nil, fmt.Errorf("row %d has a signed transaction that does not match its hash", r.Row)
+	}
+	return tx, nil
+}
+
+// releaseBatchNonces returns the reserved nonces above the highest nonce used
+// by the attempted transfers to the nonce manager, as the transfers using
+// them were not accepted by the sequencer.
+//
+// If a transfer was rejected for its nonce, or a transfer below the highest
+// used nonce was rejected, the transfers above it wait on a missing nonce.
+// The nonce manager resyncs with the sequencer instead, and the waiting
+// transfers are reconciled when the batch is resumed.
+func releaseBatchNonces(reservation *client.NonceReservation, attempted []*BatchTransferResult, errs map[*BatchTransferResult]error) {
+	// next is one past the highest nonce used by an attempted transfer. A
+	// transfer that was not rejected may be in the sequencer's mempool even
+	// if broadcasting it failed.
+	var next uint32
+	used := false
+	for _, r := range attempted {
+		if r.Status == BatchStatusSkipped || errors.Is(errs[r], errBatchTxRejected) {
+			continue
+		}
+		if !used || r.Nonce >= next {
+			next = r.Nonce + 1
+		}
+		used = true
+	}
+
+	for _, r := range attempted {
+		err := errs[r]
+		if errors.Is(err, errBatchNonceRejected) || (errors.Is(err, errBatchTxRejected) && used && r.Nonce < next) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			log.Warnf("Transfer in row %d was rejected before a later transfer was accepted, resyncing nonces", r.Row)
+			if err := reservation.Resync(ctx); err != nil {
+				log.WithError(err).Warn("Error resyncing nonces")
+			}
+			return
+		}
+	}
+
+	release := reservation.Nonce
+	if used && next > release {
+		release = next
+	}
+	if err := reservation.Release(release); err != nil {
+		log.WithError(err).Warn("Error releasing nonces")
+	}
+}
+
+// sendBatchTransfer broadcasts a signed transfer, retrying transient
+// failures, and waits for it as configured by wait. It returns the status of
+// the transfer.
+func sendBatchTransfer(ctx context.Context, c *client.Client, tx *txproto.Transaction, retries int, wait client.WaitOptions) (string, error) {
+	mode := client.WaitSync
+	if wait.Mode == client.WaitNone {
+		mode = client.WaitNone
+	}
+
+	interval := batchRetryInterval
+	for attempt := 0; ; attempt++ {
+		broadcastCtx, broadcastCancel := context.WithTimeout(ctx, 10*time.Second)
+		resp, err := c.BroadcastTx(broadcastCtx, tx, client.WaitOptions{Mode: mode})
+		broadcastCancel()
+		if err == nil || strings.Contains(err.Error(), "tx already exists in cache") {
+			break
+		}
+		// a response with a non-zero code means the sequencer rejected the
+		// transaction, which retrying will not fix
+		if resp != nil && resp.Code != 0 {
+			if client.IsNonceError(resp) {
+				return BatchStatusFailed, fmt.Errorf("%w: %w", errBatchNonceRejected, err)
+			}
+			return BatchStatusFailed, fmt.Errorf("%w: %w", errBatchTxRejected, err)
+		}
+		if attempt >= retries || ctx.Err() != nil {
+			return BatchStatusFailed, err
+		}
+
+		log.WithError(err).Debugf("Broadcast failed, retrying in %s", interval)
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			return BatchStatusFailed, ctx.Err()
+		}
+		interval *= 2
+	}
+
+	if wait.Mode == client.WaitNone || wait.Mode == client.WaitSync {
+		return BatchStatusBroadcast, nil
+	}
+
+	hash, err := TxHash(tx)
+	if err != nil {
+		return BatchStatusPending, err
+	}
+	hashBytes, err := hex.DecodeString(hash)
+	if err != nil {
+		return BatchStatusPending, err
+	}
+	// waiting is not cancelled when another transfer fails, as this
+	// transfer was already accepted
+	if _, err := c.WaitForTx(context.Background(), hashBytes, wait); err != nil {
+		if errors.Is(err, client.ErrTxFailed) {
+			return BatchStatusFailed, err
+		}
+		return BatchStatusPending, err
+	}
+	return BatchStatusCommitted, nil
+}
+
+// resumeBatchResults merges the results recorded in the results file at path,
+// if it exists, into results. The recorded transactions of rows that were not
+// committed are looked up on the sequencer. If one is not found, its row is
+// left to be broadcast again if its nonce is still unused by addr, and marked
+// as unknown otherwise.
+func resumeBatchResults(c *client.Client, path string, addr string, results []*BatchTransferResult) error {
+	if path == "" {
+		return nil
+	}
+	previous, err := readBatchResults(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	byRow := make(map[int]*BatchTransferResult, len(previous))
+	for _, p := range previous {
+		byRow[p.Row] = p
+	}
+	var chainNonce *uint32
+	for _, r := range results {
+		p, ok := byRow[r.Row]
+		if !ok {
+			continue
+		}
+		if p.Address != r.Address || p.Amount != r.Amount || p.Asset != r.Asset {
+			return fmt.Errorf("row %d of %s does not match the transfer file", r.Row, path)
+		}
+		r.Nonce, r.TxHash, r.SignedTx, r.Status, r.Error = p.Nonce, p.TxHash, p.SignedTx, p.Status, p.Error
+		if r.Status == BatchStatusCommitted || r.SignedTx == "" {
+			continue
+		}
+
+		hash, err := hex.DecodeString(r.TxHash)
+		if err != nil {
+			return fmt.Errorf("row %d of %s has an invalid tx hash: %w", r.Row, path, err)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		tx, err := c.GetTx(ctx, hash)
+		cancel()
+		switch {
+		case err == nil && tx.TxResult.Code != 0:
+			// the transfer can be signed again with a new nonce, as its
+			// transaction was executed and failed
+			r.Status, r.Error, r.SignedTx = BatchStatusFailed, tx.TxResult.Log, ""
+			continue
+		case err == nil:
+			r.Status, r.Error = BatchStatusCommitted, ""
+			continue
+		}
+
+		if chainNonce == nil {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			nonce, err := c.GetNonce(ctx, addr)
+			cancel()
+			if err != nil {
+				return fmt.Errorf("failed to get nonce: %w", err)
+			}
+			chainNonce = &nonce
+		}
+		if r.Nonce >= *chainNonce {
+			log.WithError(err).Debugf("Transfer in row %d was not found and its nonce is unused, broadcasting it again", r.Row)
+			continue
+		}
+		log.WithError(err).Warnf("Transfer in row %d was not found, but its nonce %d was used", r.Row, r.Nonce)
+		r.Status = BatchStatusUnknown
+		r.Error = fmt.Sprintf("nonce %d was used, but tx %s was not found; check whether it was committed", r.Nonce, r.TxHash)
+	}
+	return nil
+}
+
+// readBatchResults reads a results file written by writeBatchResults.
+func readBatchResults(path string) ([]*BatchTransferResult, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	header := batchResultsHeader()
+	results := make([]*BatchTransferResult, 0, len(records)-1)
+	for i, record := range records[1:] {
+		if len(record) != len(header) {
+			return nil, fmt.Errorf("line %d of %s: expected %d columns, got %d", i+2, path, len(header), len(record))
+		}
+		row, err := strconv.Atoi(record[0])
+		if err != nil {
+			return nil, fmt.Errorf("line %d of %s: invalid row: %w", i+2, path, err)
+		}
+		nonce, err := strconv.ParseUint(record[4], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("line %d of %s: invalid nonce: %w", i+2, path, err)
+		}
+		results = append(results, &BatchTransferResult{
+			Row:      row,
+			Address:  record[1],
+			Amount:   record[2],
+			Asset:    record[3],
+			Nonce:    uint32(nonce),
+			TxHash:   record[5],
+			Status:   record[6],
+			Error:    record[7],
+			SignedTx: record[8],
+		})
+	}
+	return results, nil
+}
+
+// batchResultsHeader returns the header of a results file, which holds the
+// columns of the results table and the signed transactions.
+func batchResultsHeader() []string {
+	return append((&BatchTransferResponse{}).TableHeader(), "SignedTx")
+}
+
+// writeBatchResults writes the results of a batch transfer to path as CSV. The
+// file is replaced atomically so an interrupted write never loses results.
+func writeBatchResults(path string, res *BatchTransferResponse) error {
+	if path == "" {
+		return nil
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	rows := res.TableRows()
+	for i, r := range res.Results {
+		rows[i] = append(rows[i], r.SignedTx)
+	}
+	w := csv.NewWriter(tmp)
+	if err := w.Write(batchResultsHeader()); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := w.WriteAll(rows); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,254 @@
+package sequencer
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/astriaorg/astria-cli-go/modules/go-sequencer-client/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestFile(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestLoadBatchTransferFile(t *testing.T) {
+	rows, err := LoadBatchTransferFile(writeTestFile(t, "recipients.csv", "address,amount,asset\n# comment\n"+
+		testAddress+",1000,ntia\n"+
+		testOtherAddress+", 25\n"))
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.Equal(t, &BatchTransferRow{Row: 1, Address: testAddress, Amount: "1000", Asset: "ntia"}, rows[0])
+	assert.Equal(t, &BatchTransferRow{Row: 2, Address: testOtherAddress, Amount: "25"}, rows[1])
+
+	invalid := map[string]string{
+		"empty":          "address,amount\n",
+		"bad address":    "astria1nope,1000\n",
+		"bad amount":     testAddress + ",1.5\n",
+		"missing amount": testAddress + "\n",
+		"extra column":   testAddress + ",1,ntia,extra\n",
+	}
+	for name, content := range invalid {
+		t.Run(name, func(t *testing.T) {
+			_, err := LoadBatchTransferFile(writeTestFile(t, "recipients.csv", content))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func testBatchOpts(t *testing.T, url string, rows int) BatchTransferOpts {
+	useTestNonceDir(t)
+	keyBytes, err := hex.DecodeString(testPrivKey)
+	require.NoError(t, err)
+	opts := BatchTransferOpts{
+		Wait:             client.WaitOptions{PollInterval: time.Millisecond, Timeout: 5 * time.Second},
+		AddressPrefix:    "astria",
+		SequencerURL:     url,
+		FromKey:          ed25519.NewKeyFromSeed(keyBytes),
+		SequencerChainID: "test-chain",
+		Asset:            "ntia",
+		FeeAsset:         "ntia",
+		ResultsPath:      filepath.Join(t.TempDir(), "results.csv"),
+		Concurrency:      3,
+		Retries:          2,
+		UseNonceManager:  true,
+	}
+	for i := 0; i < rows; i++ {
+		opts.Rows = append(opts.Rows, &BatchTransferRow{Row: i + 1, Address: testOtherAddress, Amount: "10"})
+	}
+	return opts
+}
+
+func TestBatchTransfer(t *testing.T) {
+	batchRetryInterval = time.Millisecond
+	server := newTestBroadcastServer(t, 0)
+	server.nonce = 5
+	server.failBroadcasts = 2
+	defer server.Close()
+
+	opts := testBatchOpts(t, server.URL, 5)
+	res, err := BatchTransfer(opts)
+	require.NoError(t, err)
+	assert.Equal(t, testAddress, res.From)
+	require.Len(t, res.Results, 5)
+	for i, r := range res.Results {
+		assert.Equal(t, BatchStatusCommitted, r.Status, "row %d", r.Row)
+		assert.Equal(t, uint32(5+i), r.Nonce, "nonces should be assigned in file order")
+		assert.Equal(t, "ntia", r.Asset, "rows without an asset should use the default")
+	}
+	assert.Equal(t, 1, server.count("abci_query"), "the nonce should only be fetched once")
+
+	saved, err := readBatchResults(opts.ResultsPath)
+	require.NoError(t, err)
+	assert.Equal(t, res.Results, saved)
+
+	// resuming a completed batch sends nothing
+	resumed, err := BatchTransfer(opts)
+	require.NoError(t, err)
+	assert.Equal(t, res.Results, resumed.Results)
+	assert.Equal(t, 7, server.count("broadcast_tx_sync"), "the 2 transient failures should be retried and nothing resent")
+}
+
+func TestBatchTransferRejectedAndResumed(t *testing.T) {
+	batchRetryInterval = time.Millisecond
+	server := newTestBroadcastServer(t, 0)
+	server.nonce = 1
+	server.rejectNonces = map[uint32]bool{2: true}
+	defer server.Close()
+
+	opts := testBatchOpts(t, server.URL, 6)
+	opts.Concurrency = 1
+	res, err := BatchTransfer(opts)
+	require.NoError(t, err)
+	statuses := make([]string, len(res.Results))
+	for i, r := range res.Results {
+		statuses[i] = r.Status
+	}
+	assert.Equal(t, []string{
+		BatchStatusCommitted, BatchStatusFailed, BatchStatusSkipped, BatchStatusSkipped, BatchStatusSkipped, BatchStatusSkipped,
+	}, statuses)
+	assert.Empty(t, res.Results[2].TxHash, "skipped transfers should not record a hash")
+
+	// the sequencer accepts the transfer once the cause is fixed, and the
+	// remaining transfers are assigned nonces from the current nonce
+	server.mu.Lock()
+	server.rejectNonces = nil
+	server.nonce = 2
+	server.broadcastNonces = nil
+	server.mu.Unlock()
+
+	resumed, err := BatchTransfer(opts)
+	require.NoError(t, err)
+	for _, r := range resumed.Results {
+		assert.Equal(t, BatchStatusCommitted, r.Status, "row %d", r.Row)
+	}
+	sort.Slice(server.broadcastNonces, func(i, j int) bool { return server.broadcastNonces[i] < server.broadcastNonces[j] })
+	assert.Equal(t, []uint32{2, 3, 4, 5, 6}, server.broadcastNonces, "committed rows should not be sent again")
+}
+
+func TestBatchTransferResumeUnconfirmed(t *testing.T) {
+	// transactions are accepted, but never found
+	server := newTestBroadcastServer(t, 1<<30)
+	server.nonce = 4
+	defer server.Close()
+
+	opts := testBatchOpts(t, server.URL, 3)
+	opts.Wait.Mode = client.WaitNone
+	first, err := BatchTransfer(opts)
+	require.NoError(t, err)
+	hashes := make([]string, len(first.Results))
+	for i, r := range first.Results {
+		assert.Equal(t, BatchStatusBroadcast, r.Status, "row %d", r.Row)
+		hashes[i] = r.TxHash
+	}
+
+	// transfers that are not found while their nonce is unused may still be
+	// in the mempool, so the same transactions are broadcast again
+	resumed, err := BatchTransfer(opts)
+	require.NoError(t, err)
+	for i, r := range resumed.Results {
+		assert.Equal(t, BatchStatusBroadcast, r.Status, "row %d", r.Row)
+		assert.Equal(t, hashes[i], r.TxHash, "row %d should not be signed again", r.Row)
+	}
+	sort.Slice(server.broadcastNonces, func(i, j int) bool { return server.broadcastNonces[i] < server.broadcastNonces[j] })
+	assert.Equal(t, []uint32{4, 4, 5, 5, 6, 6}, server.broadcastNonces)
+
+	// once their nonces were used, transfers that are still not found are
+	// not sent again
+	server.mu.Lock()
+	server.nonce = 7
+	server.broadcastNonces = nil
+	server.mu.Unlock()
+	unknown, err := BatchTransfer(opts)
+	require.NoError(t, err)
+	for i, r := range unknown.Results {
+		assert.Equal(t, BatchStatusUnknown, r.Status, "row %d", r.Row)
+		assert.Equal(t, hashes[i], r.TxHash)
+	}
+	assert.Empty(t, server.broadcastNonces)
+}
+
+func TestBatchTransferExecutionFailed(t *testing.T) {
+	server := newTestBroadcastServer(t, 0)
+	server.txCode = 5
+	defer server.Close()
+
+	opts := testBatchOpts(t, server.URL, 2)
+	opts.Concurrency = 1
+	res, err := BatchTransfer(opts)
+	require.NoError(t, err)
+	assert.Equal(t, BatchStatusFailed, res.Results[0].Status, "a transfer that failed to execute should not be committed")
+	assert.Contains(t, res.Results[0].Error, "execution failed")
+	assert.Equal(t, BatchStatusSkipped, res.Results[1].Status)
+}
+
+func TestReleaseBatchNonces(t *testing.T) {
+	dir := useTestNonceDir(t)
+	server := newTestBroadcastServer(t, 0)
+	defer server.Close()
+
+	c, err := client.NewClient(server.URL)
+	require.NoError(t, err)
+	manager := client.NewNonceManager(c, dir)
+	ctx := context.Background()
+
+	rejected := fmt.Errorf("%w: insufficient funds", errBatchTxRejected)
+	nonceRejected := fmt.Errorf("%w: invalid nonce", errBatchNonceRejected)
+	testCases := []struct {
+		name     string
+		statuses []string
+		errs     []error
+		next     uint32
+	}{
+		{"all accepted", []string{BatchStatusBroadcast, BatchStatusBroadcast, BatchStatusCommitted, BatchStatusPending}, nil, 4},
+		{"rejected after accepted", []string{BatchStatusBroadcast, BatchStatusBroadcast, BatchStatusFailed, BatchStatusSkipped}, []error{nil, nil, rejected}, 2},
+		{"broadcast failure", []string{BatchStatusBroadcast, BatchStatusFailed, BatchStatusSkipped, BatchStatusSkipped}, []error{nil, errors.New("connection refused")}, 2},
+		{"all rejected", []string{BatchStatusFailed, BatchStatusFailed, BatchStatusSkipped, BatchStatusSkipped}, []error{rejected, rejected}, 0},
+		{"rejected before accepted", []string{BatchStatusBroadcast, BatchStatusFailed, BatchStatusBroadcast, BatchStatusBroadcast}, []error{nil, rejected}, 0},
+		{"nonce rejected", []string{BatchStatusBroadcast, BatchStatusBroadcast, BatchStatusFailed, BatchStatusSkipped}, []error{nil, nil, nonceRejected}, 0},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			reservation, err := manager.Reserve(ctx, tc.name, testAddress, 4)
+			require.NoError(t, err)
+
+			attempted := make([]*BatchTransferResult, len(tc.statuses))
+			errs := map[*BatchTransferResult]error{}
+			for i, status := range tc.statuses {
+				attempted[i] = &BatchTransferResult{Row: i + 1, Nonce: uint32(i), Status: status}
+				if i < len(tc.errs) {
+					errs[attempted[i]] = tc.errs[i]
+				}
+			}
+			releaseBatchNonces(reservation, attempted, errs)
+
+			next, err := manager.Reserve(ctx, tc.name, testAddress, 1)
+			require.NoError(t, err)
+			assert.Equal(t, tc.next, next.Nonce)
+		})
+	}
+}
+
+func TestBatchTransferResumeMismatch(t *testing.T) {
+	server := newTestBroadcastServer(t, 0)
+	defer server.Close()
+
+	opts := testBatchOpts(t, server.URL, 2)
+	_, err := BatchTransfer(opts)
+	require.NoError(t, err)
+
+	opts.Rows[1].Amount = "11"
+	_, err = BatchTransfer(opts)
+	assert.ErrorContains(t, err, "does not match")
+}
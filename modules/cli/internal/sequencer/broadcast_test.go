@@ -0,0 +1,267 @@
+package sequencer
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	accountsproto "buf.build/gen/go/astria/protocol-apis/protocolbuffers/go/astria/protocol/accounts/v1"
+	txproto "buf.build/gen/go/astria/protocol-apis/protocolbuffers/go/astria/protocol/transaction/v1"
+	"github.com/astriaorg/astria-cli-go/modules/go-sequencer-client/client"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// testBroadcastServer is a fake CometBFT RPC server that includes accepted
+// transactions at height 10 once they were polled for notFoundPolls times,
+// and advances the latest height by one on each `block` request. Nonce
+// queries return nonce. The first failBroadcasts broadcasts fail with an HTTP
+// error, and transactions with a nonce in rejectNonces fail CheckTx. Included
+// transactions fail to execute with txCode if it is set.
+type testBroadcastServer struct {
+	*httptest.Server
+	mu              sync.Mutex
+	notFoundPolls   int
+	methods         []string
+	latest          int64
+	nonce           uint32
+	failBroadcasts  int
+	rejectNonces    map[uint32]bool
+	broadcastNonces []uint32
+	txCode          uint32
+	accepted        map[string]bool
+}
+
+func newTestBroadcastServer(t *testing.T, notFoundPolls int) *testBroadcastServer {
+	s := &testBroadcastServer{notFoundPolls: notFoundPolls, latest: 10, accepted: map[string]bool{}}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req rpctypes.RPCRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.methods = append(s.methods, req.Method)
+
+		var result any
+		switch req.Method {
+		case "broadcast_tx_sync", "broadcast_tx_async":
+			if s.failBroadcasts > 0 {
+				s.failBroadcasts--
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			var params struct {
+				Tx []byte `json:"tx"`
+			}
+			require.NoError(t, json.Unmarshal(req.Params, &params))
+			tx := &txproto.Transaction{}
+			require.NoError(t, proto.Unmarshal(params.Tx, tx))
+			body, err := UnpackTransactionBody(tx)
+			require.NoError(t, err)
+			nonce := body.GetParams().GetNonce()
+			s.broadcastNonces = append(s.broadcastNonces, nonce)
+			if s.rejectNonces[nonce] {
+				result = &coretypes.ResultBroadcastTx{Code: 1, Log: "rejected"}
+				break
+			}
+			hash := cmttypes.Tx(params.Tx).Hash()
+			s.accepted[string(hash)] = true
+			result = &coretypes.ResultBroadcastTx{Hash: hash}
+		case "abci_query":
+			value, err := proto.Marshal(&accountsproto.NonceResponse{Nonce: s.nonce})
+			require.NoError(t, err)
+			result = &coretypes.ResultABCIQuery{Response: abcitypes.ResponseQuery{Value: value}}
+		case "tx":
+			var params struct {
+				Hash []byte `json:"hash"`
+			}
+			require.NoError(t, json.Unmarshal(req.Params, &params))
+			if !s.accepted[string(params.Hash)] {
+				require.NoError(t, json.NewEncoder(w).Encode(rpctypes.RPCInternalError(req.ID, errors.New("tx not found"))))
+				return
+			}
+			if s.notFoundPolls > 0 {
+				s.notFoundPolls--
+				require.NoError(t, json.NewEncoder(w).Encode(rpctypes.RPCInternalError(req.ID, errors.New("tx not found"))))
+				return
+			}
+			tx := &coretypes.ResultTx{Hash: make([]byte, 32), Height: 10}
+			if s.txCode != 0 {
+				tx.TxResult = abcitypes.ExecTxResult{Code: s.txCode, Log: "execution failed"}
+			}
+			result = tx
+		case "block":
+			result = &coretypes.ResultBlock{Block: cmttypes.MakeBlock(s.latest, nil, nil, nil)}
+			s.latest++
+		default:
+			t.Fatalf("unexpected method %s", req.Method)
+		}
+
+		data, err := cmtjson.Marshal(result)
+		require.NoError(t, err)
+		require.NoError(t, json.NewEncoder(w).Encode(rpctypes.RPCResponse{JSONRPC: "2.0", ID: req.ID, Result: data}))
+	}))
+	return s
+}
+
+func (s *testBroadcastServer) count(method string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := 0
+	for _, m := range s.methods {
+		if m == method {
+			n++
+		}
+	}
+	return n
+}
+
+func TestBroadcastSignedTxWait(t *testing.T) {
+	tx, _ := signedTestTx(t)
+	txPath := filepath.Join(t.TempDir(), "tx.pb")
+	require.NoError(t, WriteProtoFile(txPath, tx))
+
+	fast := client.WaitOptions{PollInterval: time.Millisecond, Timeout: 5 * time.Second}
+
+	t.Run("none", func(t *testing.T) {
+		server := newTestBroadcastServer(t, 0)
+		defer server.Close()
+
+		wait := fast
+		wait.Mode = client.WaitNone
+		_, err := BroadcastSignedTx(BroadcastTxOpts{Wait: wait, AddressPrefix: "astria", SequencerURL: server.URL, SequencerChainID: "test-chain", InputPath: txPath})
+		require.NoError(t, err)
+		assert.Equal(t, 1, server.count("broadcast_tx_async"))
+		assert.Zero(t, server.count("tx"))
+	})
+
+	t.Run("sync", func(t *testing.T) {
+		server := newTestBroadcastServer(t, 0)
+		defer server.Close()
+
+		wait := fast
+		wait.Mode = client.WaitSync
+		_, err := BroadcastSignedTx(BroadcastTxOpts{Wait: wait, AddressPrefix: "astria", SequencerURL: server.URL, SequencerChainID: "test-chain", InputPath: txPath})
+		require.NoError(t, err)
+		assert.Equal(t, 1, server.count("broadcast_tx_sync"))
+		assert.Zero(t, server.count("tx"), "sync should not wait for inclusion")
+	})
+
+	t.Run("commit with confirmations", func(t *testing.T) {
+		server := newTestBroadcastServer(t, 3)
+		defer server.Close()
+
+		wait := fast
+		wait.Confirmations = 2
+		res, err := BroadcastSignedTx(BroadcastTxOpts{Wait: wait, AddressPrefix: "astria", SequencerURL: server.URL, SequencerChainID: "test-chain", InputPath: txPath})
+		require.NoError(t, err)
+		assert.Equal(t, testAddress, res.From)
+		assert.Equal(t, 4, server.count("tx"), "should poll until the tx is found")
+		assert.Equal(t, 3, server.count("block"), "should poll until 2 blocks were committed after inclusion")
+	})
+
+	t.Run("commit timeout", func(t *testing.T) {
+		server := newTestBroadcastServer(t, 1<<30)
+		defer server.Close()
+
+		wait := fast
+		wait.Timeout = 50 * time.Millisecond
+		_, err := BroadcastSignedTx(BroadcastTxOpts{Wait: wait, AddressPrefix: "astria", SequencerURL: server.URL, SequencerChainID: "test-chain", InputPath: txPath})
+		assert.ErrorContains(t, err, "not found after 50ms")
+	})
+
+	t.Run("commit failed", func(t *testing.T) {
+		server := newTestBroadcastServer(t, 0)
+		server.txCode = 5
+		defer server.Close()
+
+		wait := fast
+		wait.Confirmations = 2
+		_, err := BroadcastSignedTx(BroadcastTxOpts{Wait: wait, AddressPrefix: "astria", SequencerURL: server.URL, SequencerChainID: "test-chain", InputPath: txPath})
+		assert.ErrorIs(t, err, client.ErrTxFailed)
+		assert.ErrorContains(t, err, "execution failed")
+		assert.Zero(t, server.count("block"), "should not wait for confirmations of a failed tx")
+	})
+}
+
+func TestBroadcastSignedTxInvalid(t *testing.T) {
+	server := newTestBroadcastServer(t, 0)
+	defer server.Close()
+
+	tx, _ := signedTestTx(t)
+	broadcast := func(tx *txproto.Transaction, chainID string) error {
+		txPath := filepath.Join(t.TempDir(), "tx.pb")
+		require.NoError(t, WriteProtoFile(txPath, tx))
+		_, err := BroadcastSignedTx(BroadcastTxOpts{AddressPrefix: "astria", SequencerURL: server.URL, SequencerChainID: chainID, InputPath: txPath})
+		return err
+	}
+
+	assert.ErrorContains(t, broadcast(tx, "other-chain"), `transaction is for chain "test-chain", not "other-chain"`)
+
+	tampered := proto.Clone(tx).(*txproto.Transaction)
+	tampered.Signature[0] ^= 0xff
+	assert.ErrorContains(t, broadcast(tampered, "test-chain"), "signature does not verify")
+
+	assert.Empty(t, server.methods, "invalid transactions should not be broadcast")
+}
+
+func TestSignAndBroadcast(t *testing.T) {
+	dir := useTestNonceDir(t)
+	server := newTestBroadcastServer(t, 0)
+	server.nonce = 4
+	defer server.Close()
+
+	keyBytes, err := hex.DecodeString(testPrivKey)
+	require.NoError(t, err)
+	res, err := AddFeeAsset(FeeAssetOpts{
+		Wait:             client.WaitOptions{PollInterval: time.Millisecond, Timeout: 5 * time.Second},
+		AddressPrefix:    "astria",
+		FromKey:          ed25519.NewKeyFromSeed(keyBytes),
+		SequencerURL:     server.URL,
+		SequencerChainID: "test-chain",
+		Asset:            "ntia",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, testAddress, res.From)
+	assert.Equal(t, uint32(4), res.Nonce)
+	assert.Equal(t, "ntia", res.FeeAssetId)
+	assert.Len(t, res.TxHash, 64)
+	assert.Equal(t, []uint32{4}, server.broadcastNonces)
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, entries, "nonces should only be tracked with the nonce manager")
+
+	// a managed nonce is released when the transaction is not accepted
+	server.mu.Lock()
+	server.failBroadcasts = 1
+	server.mu.Unlock()
+	opts := signerOpts{
+		SequencerURL:     server.URL,
+		SequencerChainID: "test-chain",
+		AddressPrefix:    "astria",
+		FromKey:          ed25519.NewKeyFromSeed(keyBytes),
+		Wait:             client.WaitOptions{PollInterval: time.Millisecond, Timeout: 5 * time.Second},
+		UseNonceManager:  true,
+	}
+	actions := testBody(t).Actions
+	_, err = signAndBroadcast(context.Background(), opts, actions...)
+	require.Error(t, err)
+	managed, err := signAndBroadcast(context.Background(), opts, actions...)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(4), managed.Nonce)
+}
@@ -0,0 +1,146 @@
+package sequencer
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/astriaorg/astria-cli-go/modules/bech32m"
+	"github.com/astriaorg/astria-cli-go/modules/go-sequencer-client/client"
+	cmttypes "github.com/cometbft/cometbft/types"
+	log "github.com/sirupsen/logrus"
+)
+
+// GetValidators returns the sequencer's validator set at opts.Height, or at
+// the latest height if opts.Height is 0.
+func GetValidators(opts ValidatorsOpts) (*ValidatorsResponse, error) {
+	log.Debug("Creating CometBFT client with url: ", opts.SequencerURL)
+
+	c, err := client.NewClient(opts.SequencerURL)
+	if err != nil {
+		log.WithError(err).Error("Error creating sequencer client")
+		return &ValidatorsResponse{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var height *int64
+	if opts.Height != 0 {
+		height = &opts.Height
+	}
+	res, err := c.GetValidators(ctx, height)
+	if err != nil {
+		log.WithError(err).Error("Error getting validators")
+		return &ValidatorsResponse{}, err
+	}
+
+	validators := make([]*Validator, len(res.Validators))
+	total := int64(0)
+	for i, v := range res.Validators {
+		validators[i], err = validatorFromCometBFT(opts.AddressPrefix, v)
+		if err != nil {
+			log.WithError(err).Error("Error encoding validator address")
+			return &ValidatorsResponse{}, err
+		}
+		total += v.VotingPower
+	}
+
+	log.Debugf("Retrieved %d validators at block height: %d", len(validators), res.BlockHeight)
+	return &ValidatorsResponse{
+		Height:           res.BlockHeight,
+		TotalVotingPower: total,
+		Validators:       validators,
+	}, nil
+}
+
+// GetStatus returns a summary of the state of the sequencer node: its
+// latest block, whether it is catching up, and the validator set.
+func GetStatus(opts StatusOpts) (*StatusResponse, error) {
+	log.Debug("Creating CometBFT client with url: ", opts.SequencerURL)
+
+	c, err := client.NewClient(opts.SequencerURL)
+	if err != nil {
+		log.WithError(err).Error("Error creating sequencer client")
+		return &StatusResponse{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	status, err := c.GetStatus(ctx)
+	if err != nil {
+		log.WithError(err).Error("Error getting status")
+		return &StatusResponse{}, err
+	}
+
+	height := status.SyncInfo.LatestBlockHeight
+	validators, err := c.GetValidators(ctx, &height)
+	if err != nil {
+		log.WithError(err).Error("Error getting validators")
+		return &StatusResponse{}, err
+	}
+	total := int64(0)
+	for _, v := range validators.Validators {
+		total += v.VotingPower
+	}
+
+	sr := &StatusResponse{
+		Moniker:             status.NodeInfo.Moniker,
+		NodeID:              string(status.NodeInfo.DefaultNodeID),
+		Network:             status.NodeInfo.Network,
+		Version:             status.NodeInfo.Version,
+		LatestBlockHeight:   height,
+		LatestBlockHash:     hex.EncodeToString(status.SyncInfo.LatestBlockHash),
+		LatestAppHash:       hex.EncodeToString(status.SyncInfo.LatestAppHash),
+		LatestBlockTime:     status.SyncInfo.LatestBlockTime.UTC().Format(time.RFC3339Nano),
+		EarliestBlockHeight: status.SyncInfo.EarliestBlockHeight,
+		CatchingUp:          status.SyncInfo.CatchingUp,
+		VotingPower:         status.ValidatorInfo.VotingPower,
+		Validators:          len(validators.Validators),
+		TotalVotingPower:    total,
+	}
+	if status.ValidatorInfo.VotingPower > 0 {
+		addr, err := validatorAddress(opts.AddressPrefix, status.ValidatorInfo.Address)
+		if err != nil {
+			log.WithError(err).Error("Error encoding validator address")
+			return &StatusResponse{}, err
+		}
+		sr.ValidatorAddress = addr
+	}
+	return sr, nil
+}
+
+// validatorFromCometBFT converts a CometBFT validator, encoding its address
+// with the given prefix.
+func validatorFromCometBFT(prefix string, v *cmttypes.Validator) (*Validator, error) {
+	addr, err := validatorAddress(prefix, v.Address)
+	if err != nil {
+		return nil, err
+	}
+	pubKey := ""
+	if v.PubKey != nil {
+		pubKey = hex.EncodeToString(v.PubKey.Bytes())
+	}
+	return &Validator{
+		Address:          addr,
+		PubKey:           pubKey,
+		VotingPower:      v.VotingPower,
+		ProposerPriority: v.ProposerPriority,
+	}, nil
+}
+
+// validatorAddress encodes a CometBFT validator address as a bech32m address
+// with the given prefix. Both are the first 20 bytes of the SHA-256 hash of
+// the validator's ed25519 public key.
+func validatorAddress(prefix string, address []byte) (string, error) {
+	if len(address) != 20 {
+		return "", fmt.Errorf("validator address must be 20 bytes, got %d", len(address))
+	}
+	addr, err := bech32m.EncodeFromBytes(prefix, [20]byte(address))
+	if err != nil {
+		return "", err
+	}
+	return addr.String(), nil
+}
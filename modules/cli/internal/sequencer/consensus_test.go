@@ -0,0 +1,125 @@
+package sequencer
+
+import (
+	stded25519 "crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/p2p"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestConsensusServer serves CometBFT `status` and paginated `validators`
+// requests for a node at latest, whose validator set is validators.
+func newTestConsensusServer(t *testing.T, latest int64, validators []*cmttypes.Validator, nodeValidator *cmttypes.Validator) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req rpctypes.RPCRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		var result any
+		switch req.Method {
+		case "status":
+			result = &coretypes.ResultStatus{
+				NodeInfo: p2p.DefaultNodeInfo{Moniker: "node0", Network: "test-chain", Version: "0.38.0"},
+				SyncInfo: coretypes.SyncInfo{
+					LatestBlockHash:     make([]byte, 32),
+					LatestAppHash:       make([]byte, 32),
+					LatestBlockHeight:   latest,
+					LatestBlockTime:     time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+					EarliestBlockHeight: 1,
+					CatchingUp:          true,
+				},
+				ValidatorInfo: coretypes.ValidatorInfo{
+					Address:     nodeValidator.Address,
+					PubKey:      nodeValidator.PubKey,
+					VotingPower: nodeValidator.VotingPower,
+				},
+			}
+		case "validators":
+			var params struct {
+				Height  *string `json:"height"`
+				Page    *string `json:"page"`
+				PerPage *string `json:"per_page"`
+			}
+			require.NoError(t, json.Unmarshal(req.Params, &params))
+			height := latest
+			if params.Height != nil {
+				h, err := strconv.ParseInt(*params.Height, 10, 64)
+				require.NoError(t, err)
+				height = h
+			}
+			// serve one validator per page to exercise pagination
+			page, err := strconv.Atoi(*params.Page)
+			require.NoError(t, err)
+			result = &coretypes.ResultValidators{
+				BlockHeight: height,
+				Validators:  validators[page-1 : page],
+				Count:       1,
+				Total:       len(validators),
+			}
+		default:
+			t.Fatalf("unexpected method %s", req.Method)
+		}
+
+		data, err := cmtjson.Marshal(result)
+		require.NoError(t, err)
+		require.NoError(t, json.NewEncoder(w).Encode(rpctypes.RPCResponse{JSONRPC: "2.0", ID: req.ID, Result: data}))
+	}))
+}
+
+// testValidators returns a validator set whose first validator has the key
+// of testAddress.
+func testValidators(t *testing.T) []*cmttypes.Validator {
+	keyBytes, err := hex.DecodeString(testPrivKey)
+	require.NoError(t, err)
+	pubKey := stded25519.NewKeyFromSeed(keyBytes).Public().(stded25519.PublicKey)
+	own := cmttypes.NewValidator(ed25519.PubKey(pubKey), 10)
+	own.ProposerPriority = -5
+	other := cmttypes.NewValidator(ed25519.GenPrivKey().PubKey(), 5)
+	other.ProposerPriority = 5
+	return []*cmttypes.Validator{own, other}
+}
+
+func TestGetValidators(t *testing.T) {
+	validators := testValidators(t)
+	server := newTestConsensusServer(t, 12, validators, validators[0])
+	defer server.Close()
+
+	res, err := GetValidators(ValidatorsOpts{AddressPrefix: "astria", SequencerURL: server.URL, Height: 7})
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), res.Height)
+	assert.Equal(t, int64(15), res.TotalVotingPower)
+	require.Len(t, res.Validators, 2, "every page should be retrieved")
+	assert.Equal(t, testAddress, res.Validators[0].Address, "the validator address should match the account address of its key")
+	assert.Equal(t, hex.EncodeToString(validators[0].PubKey.Bytes()), res.Validators[0].PubKey)
+	assert.Equal(t, int64(10), res.Validators[0].VotingPower)
+	assert.Equal(t, int64(-5), res.Validators[0].ProposerPriority)
+}
+
+func TestGetStatus(t *testing.T) {
+	validators := testValidators(t)
+	server := newTestConsensusServer(t, 12, validators, validators[0])
+	defer server.Close()
+
+	status, err := GetStatus(StatusOpts{AddressPrefix: "astria", SequencerURL: server.URL})
+	require.NoError(t, err)
+	assert.Equal(t, "test-chain", status.Network)
+	assert.Equal(t, int64(12), status.LatestBlockHeight)
+	assert.Equal(t, "2024-01-02T03:04:05Z", status.LatestBlockTime)
+	assert.True(t, status.CatchingUp)
+	assert.Equal(t, testAddress, status.ValidatorAddress)
+	assert.Equal(t, int64(10), status.VotingPower)
+	assert.Equal(t, 2, status.Validators)
+	assert.Equal(t, int64(15), status.TotalVotingPower)
+}
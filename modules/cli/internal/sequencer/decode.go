@@ -0,0 +1,398 @@
+package sequencer
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	primproto "buf.build/gen/go/astria/primitives/protocolbuffers/go/astria/primitive/v1"
+	txproto "buf.build/gen/go/astria/protocol-apis/protocolbuffers/go/astria/protocol/transaction/v1"
+	"github.com/astriaorg/astria-cli-go/modules/bech32m"
+	"github.com/astriaorg/astria-cli-go/modules/go-sequencer-client/client"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"google.golang.org/protobuf/proto"
+)
+
+// DecodedAction is a human readable representation of a transaction action.
+type DecodedAction struct {
+	// Type is the name of the action, ie. "transfer"
+	Type string `json:"type"`
+	// Fields holds the action's populated fields rendered as strings, keyed by
+	// their protobuf JSON name. Addresses are bech32m encoded, amounts are
+	// base 10 integers and rollup IDs are hex encoded.
+	Fields map[string]string `json:"fields"`
+}
+
+// Summary returns the action's fields as space separated `key=value` pairs,
+// sorted by key.
+func (da DecodedAction) Summary() string {
+	keys := make([]string, 0, len(da.Fields))
+	for k := range da.Fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, k+"="+da.Fields[k])
+	}
+	return strings.Join(pairs, " ")
+}
+
+// addressString returns the bech32m string of an address, or an empty string
+// if the address is unset.
+func addressString(addr *primproto.Address) string {
+	return addr.GetBech32M()
+}
+
+// amountString returns a Uint128 as a base 10 string, or an empty string if
+// the amount is unset.
+func amountString(amount *primproto.Uint128) string {
+	if amount == nil {
+		return ""
+	}
+	return client.ProtoU128ToBigInt(amount).String()
+}
+
+// rollupIDString returns the hex encoding of a rollup ID, or an empty string
+// if the rollup ID is unset.
+func rollupIDString(id *primproto.RollupId) string {
+	if id == nil {
+		return ""
+	}
+	return hex.EncodeToString(id.Inner)
+}
+
+// DecodeAction converts an Action protobuf into a DecodedAction.
+func DecodeAction(action *txproto.Action) DecodedAction {
+	fields := map[string]string{}
+	set := func(key, value string) {
+		if value != "" {
+			fields[key] = value
+		}
+	}
+
+	switch v := action.GetValue().(type) {
+	case *txproto.Action_Transfer:
+		set("to", addressString(v.Transfer.GetTo()))
+		set("amount", amountString(v.Transfer.GetAmount()))
+		set("asset", v.Transfer.GetAsset())
+		set("feeAsset", v.Transfer.GetFeeAsset())
+	case *txproto.Action_RollupDataSubmission:
+		set("rollupId", rollupIDString(v.RollupDataSubmission.GetRollupId()))
+		set("dataSize", strconv.Itoa(len(v.RollupDataSubmission.GetData())))
+		set("feeAsset", v.RollupDataSubmission.GetFeeAsset())
+	case *txproto.Action_InitBridgeAccount:
+		set("rollupId", rollupIDString(v.InitBridgeAccount.GetRollupId()))
+		set("asset", v.InitBridgeAccount.GetAsset())
+		set("feeAsset", v.InitBridgeAccount.GetFeeAsset())
+		set("sudoAddress", addressString(v.InitBridgeAccount.GetSudoAddress()))
+		set("withdrawerAddress", addressString(v.InitBridgeAccount.GetWithdrawerAddress()))
+	case *txproto.Action_BridgeLock:
+		set("to", addressString(v.BridgeLock.GetTo()))
+		set("amount", amountString(v.BridgeLock.GetAmount()))
+		set("asset", v.BridgeLock.GetAsset())
+		set("feeAsset", v.BridgeLock.GetFeeAsset())
+		set("destinationChainAddress", v.BridgeLock.GetDestinationChainAddress())
+	case *txproto.Action_BridgeUnlock:
+		set("to", addressString(v.BridgeUnlock.GetTo()))
+		set("amount", amountString(v.BridgeUnlock.GetAmount()))
+		set("feeAsset", v.BridgeUnlock.GetFeeAsset())
+		set("memo", v.BridgeUnlock.GetMemo())
+		set("bridgeAddress", addressString(v.BridgeUnlock.GetBridgeAddress()))
+		set("rollupBlockNumber", strconv.FormatUint(v.BridgeUnlock.GetRollupBlockNumber(), 10))
+		set("rollupWithdrawalEventId", v.BridgeUnlock.GetRollupWithdrawalEventId())
+	case *txproto.Action_BridgeSudoChange:
+		set("bridgeAddress", addressString(v.BridgeSudoChange.GetBridgeAddress()))
+		set("newSudoAddress", addressString(v.BridgeSudoChange.GetNewSudoAddress()))
+		set("newWithdrawerAddress", addressString(v.BridgeSudoChange.GetNewWithdrawerAddress()))
+		set("feeAsset", v.BridgeSudoChange.GetFeeAsset())
+	case *txproto.Action_Ibc:
+		set("typeUrl", v.Ibc.GetRawAction().GetTypeUrl())
+	case *txproto.Action_Ics20Withdrawal:
+		w := v.Ics20Withdrawal
+		set("amount", amountString(w.GetAmount()))
+		set("denom", w.GetDenom())
+		set("destinationChainAddress", w.GetDestinationChainAddress())
+		set("returnAddress", addressString(w.GetReturnAddress()))
+		if h := w.GetTimeoutHeight(); h != nil {
+			set("timeoutHeight", fmt.Sprintf("%d-%d", h.GetRevisionNumber(), h.GetRevisionHeight()))
+		}
+		if w.GetTimeoutTime() != 0 {
+			set("timeoutTime", strconv.FormatUint(w.GetTimeoutTime(), 10))
+		}
+		set("sourceChannel", w.GetSourceChannel())
+		set("feeAsset", w.GetFeeAsset())
+		set("memo", w.GetMemo())
+		set("bridgeAddress", addressString(w.GetBridgeAddress()))
+	case *txproto.Action_SudoAddressChange:
+		set("newAddress", addressString(v.SudoAddressChange.GetNewAddress()))
+	case *txproto.Action_ValidatorUpdate:
+		set("pubKey", hex.EncodeToString(v.ValidatorUpdate.GetPubKey().GetEd25519()))
+		set("power", strconv.FormatInt(v.ValidatorUpdate.GetPower(), 10))
+	case *txproto.Action_IbcRelayerChange:
+		set("addition", addressString(v.IbcRelayerChange.GetAddition()))
+		set("removal", addressString(v.IbcRelayerChange.GetRemoval()))
+	case *txproto.Action_FeeAssetChange:
+		set("addition", v.FeeAssetChange.GetAddition())
+		set("removal", v.FeeAssetChange.GetRemoval())
+	case *txproto.Action_FeeChange:
+		m := v.FeeChange.ProtoReflect()
+		if field := m.WhichOneof(m.Descriptor().Oneofs().ByName("fee_components")); field != nil {
+			set("feeComponents", string(field.Name()))
+		}
+	case *txproto.Action_IbcSudoChange:
+		set("newAddress", addressString(v.IbcSudoChange.GetNewAddress()))
+	}
+
+	return DecodedAction{
+		Type:   actionName(action),
+		Fields: fields,
+	}
+}
+
+// DecodeActions converts a list of Action protobufs into DecodedActions.
+func DecodeActions(actions []*txproto.Action) []DecodedAction {
+	decoded := make([]DecodedAction, 0, len(actions))
+	for _, action := range actions {
+		decoded = append(decoded, DecodeAction(action))
+	}
+	return decoded
+}
+
+// DecodeTx decodes a signed transaction, verifies its signature and derives
+// the signer's address from its public key.
+func DecodeTx(tx *txproto.Transaction, addressPrefix string) (*DecodedTxResponse, error) {
+	body, err := UnpackTransactionBody(tx)
+	if err != nil {
+		return &DecodedTxResponse{}, err
+	}
+	hash, err := TxHash(tx)
+	if err != nil {
+		return &DecodedTxResponse{}, err
+	}
+
+	res := &DecodedTxResponse{
+		TxHash:    hash,
+		PublicKey: hex.EncodeToString(tx.GetPublicKey()),
+		ChainID:   body.GetParams().GetChainId(),
+		Nonce:     body.GetParams().GetNonce(),
+		Actions:   DecodeActions(body.GetActions()),
+	}
+	// ed25519.Verify panics on keys of the wrong length, so only verify and
+	// derive the signer for well formed keys
+	if len(tx.GetPublicKey()) == ed25519.PublicKeySize {
+		pub := ed25519.PublicKey(tx.GetPublicKey())
+		res.SignatureValid = ed25519.Verify(pub, tx.GetBody().GetValue(), tx.GetSignature())
+		from, err := bech32m.EncodeFromPublicKey(addressPrefix, pub)
+		if err != nil {
+			return &DecodedTxResponse{}, err
+		}
+		res.From = from.String()
+	}
+	return res, nil
+}
+
+// DecodeTxBytes decodes a protobuf encoded signed transaction. The hash is
+// computed over the given bytes, matching the hash CometBFT reports for the
+// transaction.
+func DecodeTxBytes(data []byte, addressPrefix string) (*DecodedTxResponse, error) {
+	tx := &txproto.Transaction{}
+	if err := proto.Unmarshal(data, tx); err != nil {
+		return &DecodedTxResponse{}, fmt.Errorf("failed to decode transaction: %w", err)
+	}
+	res, err := DecodeTx(tx, addressPrefix)
+	if err != nil {
+		return res, err
+	}
+	hash := sha256.Sum256(data)
+	res.TxHash = hex.EncodeToString(hash[:])
+	return res, nil
+}
+
+// Encodings of a transaction given to DecodeTransaction.
+const (
+	TxEncodingHex    = "hex"
+	TxEncodingBase64 = "base64"
+)
+
+// DecodeTransaction decodes the signed transaction given in opts.Input. The
+// input is either `@path` to read a file, or the hex or base64 encoding of a
+// protobuf encoded transaction. Files with a `.json` extension are read as
+// protobuf JSON, all other files as protobuf binary.
+//
+// The encoding is opts.Encoding if it is set. Otherwise `0x` prefixed input is
+// hex, and other input is decoded with whichever encoding it is valid in. As
+// hex digits are valid base64 characters, input valid in both is rejected
+// rather than guessed.
+func DecodeTransaction(opts DecodeTxOpts) (*DecodedTxResponse, error) {
+	input := strings.TrimSpace(opts.Input)
+
+	if path, ok := strings.CutPrefix(input, "@"); ok {
+		if isJSONPath(path) {
+			tx := &txproto.Transaction{}
+			if err := ReadProtoFile(path, tx); err != nil {
+				return &DecodedTxResponse{}, err
+			}
+			return DecodeTx(tx, opts.AddressPrefix)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return &DecodedTxResponse{}, err
+		}
+		return DecodeTxBytes(data, opts.AddressPrefix)
+	}
+
+	data, err := decodeTxInput(input, opts.Encoding)
+	if err != nil {
+		return &DecodedTxResponse{}, err
+	}
+	return DecodeTxBytes(data, opts.AddressPrefix)
+}
+
+// decodeTxInput decodes a hex or base64 encoded transaction as described by
+// DecodeTransaction.
+func decodeTxInput(input string, encoding string) ([]byte, error) {
+	switch encoding {
+	case TxEncodingHex:
+		data, err := hex.DecodeString(strings.TrimPrefix(input, "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("transaction is not valid hex: %w", err)
+		}
+		return data, nil
+	case TxEncodingBase64:
+		data, err := base64.StdEncoding.DecodeString(input)
+		if err != nil {
+			return nil, fmt.Errorf("transaction is not valid base64: %w", err)
+		}
+		return data, nil
+	case "":
+	default:
+		return nil, fmt.Errorf("unknown encoding %q, expected %q or %q", encoding, TxEncodingHex, TxEncodingBase64)
+	}
+
+	if strings.HasPrefix(input, "0x") {
+		return decodeTxInput(input, TxEncodingHex)
+	}
+	hexData, hexErr := hex.DecodeString(input)
+	base64Data, base64Err := base64.StdEncoding.DecodeString(input)
+	switch {
+	case hexErr == nil && base64Err == nil:
+		return nil, fmt.Errorf("transaction is valid as both hex and base64, prefix hex with 0x or set the encoding")
+	case hexErr == nil:
+		return hexData, nil
+	case base64Err == nil:
+		return base64Data, nil
+	default:
+		return nil, fmt.Errorf("transaction is neither valid hex nor base64")
+	}
+}
+
+// DecodedTxFilter selects decoded transactions. Empty fields match every
+// transaction, set fields must all match.
+type DecodedTxFilter struct {
+	// ActionType matches transactions containing an action of the type, ie.
+	// "transfer"
+	ActionType string
+	// Address matches transactions signed by the bech32m address or with an
+	// action referencing it
+	Address string
+	// Rollup matches transactions with an action referencing the rollup,
+	// given as either its name or its hex encoded ID
+	Rollup string
+}
+
+// IsEmpty returns true if the filter matches every transaction.
+func (f DecodedTxFilter) IsEmpty() bool {
+	return f.ActionType == "" && f.Address == "" && f.Rollup == ""
+}
+
+// Matches returns true if the transaction passes the filter.
+func (f DecodedTxFilter) Matches(tx *DecodedTxResponse) bool {
+	if f.ActionType != "" && !tx.hasAction(func(a DecodedAction) bool {
+		return a.Type == f.ActionType
+	}) {
+		return false
+	}
+
+	if f.Address != "" && tx.From != f.Address && !tx.hasAction(func(a DecodedAction) bool {
+		for _, v := range a.Fields {
+			if v == f.Address {
+				return true
+			}
+		}
+		return false
+	}) {
+		return false
+	}
+
+	if f.Rollup != "" {
+		byName := rollupIDString(rollupIdFromText(f.Rollup))
+		byID := strings.ToLower(strings.TrimPrefix(f.Rollup, "0x"))
+		if !tx.hasAction(func(a DecodedAction) bool {
+			id := a.Fields["rollupId"]
+			return id != "" && (id == byName || id == byID)
+		}) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// hasAction returns true if any of the transaction's actions satisfy match.
+func (dtr *DecodedTxResponse) hasAction(match func(DecodedAction) bool) bool {
+	for _, action := range dtr.Actions {
+		if match(action) {
+			return true
+		}
+	}
+	return false
+}
+
+// blockCommitments are the names of the rollup commitments the sequencer
+// puts at the start of each block, in order.
+var blockCommitments = []string{CommitmentRollupDatasRoot, CommitmentRollupIdsRoot}
+
+// decodeBlockTxs decodes the transactions of a block, keeping those matching
+// filter. The rollup commitments at the start of the block and transactions
+// that cannot be decoded are kept only when the filter is empty.
+func decodeBlockTxs(txs cmttypes.Txs, addressPrefix string, filter DecodedTxFilter) []*BlockTx {
+	decoded := []*BlockTx{}
+	for i, data := range txs {
+		hash := sha256.Sum256(data)
+		if i < len(blockCommitments) && len(data) == sha256.Size {
+			if filter.IsEmpty() {
+				decoded = append(decoded, &BlockTx{
+					Index:             i,
+					Commitment:        blockCommitments[i],
+					Root:              hex.EncodeToString(data),
+					DecodedTxResponse: DecodedTxResponse{TxHash: hex.EncodeToString(hash[:])},
+				})
+			}
+			continue
+		}
+
+		tx, err := DecodeTxBytes(data, addressPrefix)
+		if err != nil {
+			if filter.IsEmpty() {
+				decoded = append(decoded, &BlockTx{
+					Index:             i,
+					Error:             err.Error(),
+					DecodedTxResponse: DecodedTxResponse{TxHash: hex.EncodeToString(hash[:])},
+				})
+			}
+			continue
+		}
+		if filter.Matches(tx) {
+			decoded = append(decoded, &BlockTx{Index: i, DecodedTxResponse: *tx})
+		}
+	}
+	return decoded
+}
@@ -0,0 +1,227 @@
+package sequencer
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	primproto "buf.build/gen/go/astria/primitives/protocolbuffers/go/astria/primitive/v1"
+	txproto "buf.build/gen/go/astria/protocol-apis/protocolbuffers/go/astria/protocol/transaction/v1"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func signedTestTx(t *testing.T) (*txproto.Transaction, []byte) {
+	return signTestActions(t, testBody(t).Actions)
+}
+
+// signTestActions signs a transaction containing actions with testPrivKey.
+func signTestActions(t *testing.T, actions []*txproto.Action) (*txproto.Transaction, []byte) {
+	dir := t.TempDir()
+	bodyPath := filepath.Join(dir, "body.pb")
+	txPath := filepath.Join(dir, "tx.pb")
+	_, err := CreateTx(CreateTxOpts{
+		SequencerChainID: "test-chain",
+		Nonce:            7,
+		Actions:          actions,
+		OutputPath:       bodyPath,
+	})
+	require.NoError(t, err)
+
+	keyBytes, err := hex.DecodeString(testPrivKey)
+	require.NoError(t, err)
+	_, err = SignTx(SignTxOpts{
+		AddressPrefix: "astria",
+		FromKey:       ed25519.NewKeyFromSeed(keyBytes),
+		InputPath:     bodyPath,
+		OutputPath:    txPath,
+	})
+	require.NoError(t, err)
+
+	tx := &txproto.Transaction{}
+	require.NoError(t, ReadProtoFile(txPath, tx))
+	data, err := proto.Marshal(tx)
+	require.NoError(t, err)
+	return tx, data
+}
+
+func TestDecodeAction(t *testing.T) {
+	action := &txproto.Action{
+		Value: &txproto.Action_BridgeLock{
+			BridgeLock: &txproto.BridgeLock{
+				To:                      &primproto.Address{Bech32M: testAddress},
+				Amount:                  &primproto.Uint128{Lo: 5, Hi: 1},
+				Asset:                   "ntia",
+				DestinationChainAddress: "0xabc",
+			},
+		},
+	}
+
+	decoded := DecodeAction(action)
+	assert.Equal(t, "bridge_lock", decoded.Type)
+	assert.Equal(t, testAddress, decoded.Fields["to"])
+	assert.Equal(t, "18446744073709551621", decoded.Fields["amount"])
+	assert.NotContains(t, decoded.Fields, "feeAsset", "unset fields should be omitted")
+	assert.Equal(t, "amount=18446744073709551621 asset=ntia destinationChainAddress=0xabc to="+testAddress, decoded.Summary())
+
+	rollup := DecodeAction(&txproto.Action{
+		Value: &txproto.Action_RollupDataSubmission{
+			RollupDataSubmission: &txproto.RollupDataSubmission{
+				RollupId: rollupIdFromText("test-rollup"),
+				Data:     []byte("data"),
+			},
+		},
+	})
+	assert.Equal(t, hex.EncodeToString(rollupIdFromText("test-rollup").Inner), rollup.Fields["rollupId"])
+	assert.Equal(t, "4", rollup.Fields["dataSize"])
+}
+
+func TestDecodeTransaction(t *testing.T) {
+	tx, data := signedTestTx(t)
+	expectedHash, err := TxHash(tx)
+	require.NoError(t, err)
+
+	inputs := map[string]DecodeTxOpts{
+		"hex":        {Input: hex.EncodeToString(data), Encoding: TxEncodingHex},
+		"0x hex":     {Input: "0x" + hex.EncodeToString(data)},
+		"base64":     {Input: base64.StdEncoding.EncodeToString(data)},
+		"proto file": {Input: "@" + filepath.Join(t.TempDir(), "tx.pb")},
+	}
+	require.NoError(t, WriteProtoFile(inputs["proto file"].Input[1:], tx))
+	inputs["json file"] = DecodeTxOpts{Input: "@" + JSONRenderingPath(inputs["proto file"].Input[1:])}
+
+	for name, opts := range inputs {
+		t.Run(name, func(t *testing.T) {
+			opts.AddressPrefix = "astria"
+			decoded, err := DecodeTransaction(opts)
+			require.NoError(t, err)
+			assert.Equal(t, expectedHash, decoded.TxHash)
+			assert.Equal(t, testAddress, decoded.From)
+			assert.True(t, decoded.SignatureValid)
+			assert.Equal(t, "test-chain", decoded.ChainID)
+			assert.Equal(t, uint32(7), decoded.Nonce)
+			require.Len(t, decoded.Actions, 1)
+			assert.Equal(t, "transfer", decoded.Actions[0].Type)
+			assert.Equal(t, "1000", decoded.Actions[0].Fields["amount"])
+		})
+	}
+}
+
+func TestDecodeTxInvalidSignature(t *testing.T) {
+	tx, _ := signedTestTx(t)
+	tx.Signature[0] ^= 0xff
+
+	decoded, err := DecodeTx(tx, "astria")
+	require.NoError(t, err)
+	assert.False(t, decoded.SignatureValid, "tampered signature should not verify")
+}
+
+func TestDecodeTransactionInvalidInput(t *testing.T) {
+	_, err := DecodeTransaction(DecodeTxOpts{AddressPrefix: "astria", Input: "not a tx!"})
+	assert.Error(t, err)
+}
+
+func TestDecodeTxInputEncoding(t *testing.T) {
+	// "deadbeef" is valid hex and valid base64, and decodes to different bytes
+	_, err := decodeTxInput("deadbeef", "")
+	assert.ErrorContains(t, err, "both hex and base64", "ambiguous input should not be guessed")
+
+	data, err := decodeTxInput("0xdeadbeef", "")
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0xde, 0xad, 0xbe, 0xef}, data)
+
+	data, err = decodeTxInput("deadbeef", TxEncodingHex)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0xde, 0xad, 0xbe, 0xef}, data)
+
+	data, err = decodeTxInput("deadbeef", TxEncodingBase64)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x75, 0xe6, 0x9d, 0x6d, 0xe7, 0x9f}, data)
+
+	_, err = decodeTxInput("deadbeef", "base58")
+	assert.ErrorContains(t, err, "unknown encoding")
+}
+
+func TestDecodedTxFilter(t *testing.T) {
+	tx := &DecodedTxResponse{
+		From: testAddress,
+		Actions: []DecodedAction{
+			{Type: "transfer", Fields: map[string]string{"to": testOtherAddress}},
+			{Type: "rollup_data_submission", Fields: map[string]string{"rollupId": rollupIDString(rollupIdFromText("test-rollup"))}},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		filter  DecodedTxFilter
+		matches bool
+	}{
+		{"empty", DecodedTxFilter{}, true},
+		{"action", DecodedTxFilter{ActionType: "transfer"}, true},
+		{"missing action", DecodedTxFilter{ActionType: "bridge_lock"}, false},
+		{"signer", DecodedTxFilter{Address: testAddress}, true},
+		{"referenced address", DecodedTxFilter{Address: testOtherAddress}, true},
+		{"other address", DecodedTxFilter{Address: "astria1other"}, false},
+		{"rollup name", DecodedTxFilter{Rollup: "test-rollup"}, true},
+		{"rollup id", DecodedTxFilter{Rollup: "0x" + rollupIDString(rollupIdFromText("test-rollup"))}, true},
+		{"other rollup", DecodedTxFilter{Rollup: "other-rollup"}, false},
+		{"all", DecodedTxFilter{ActionType: "transfer", Address: testOtherAddress, Rollup: "test-rollup"}, true},
+		{"one mismatch", DecodedTxFilter{ActionType: "bridge_lock", Address: testOtherAddress}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.matches, tt.filter.Matches(tx))
+		})
+	}
+}
+
+func TestDecodeBlockTxs(t *testing.T) {
+	_, data := signedTestTx(t)
+	rollupDatasRoot := bytes.Repeat([]byte{1}, 32)
+	rollupIdsRoot := bytes.Repeat([]byte{2}, 32)
+	txs := cmttypes.Txs{rollupDatasRoot, rollupIdsRoot, data, []byte("garbage")}
+
+	all := decodeBlockTxs(txs, "astria", DecodedTxFilter{})
+	require.Len(t, all, 4)
+	assert.Equal(t, CommitmentRollupDatasRoot, all[0].Commitment)
+	assert.Equal(t, hex.EncodeToString(rollupDatasRoot), all[0].Root)
+	assert.Empty(t, all[0].Error, "commitments should not be decode errors")
+	assert.Equal(t, CommitmentRollupIdsRoot, all[1].Commitment)
+	assert.Equal(t, 2, all[2].Index)
+	assert.Equal(t, testAddress, all[2].From)
+	assert.Empty(t, all[3].Commitment)
+	assert.NotEmpty(t, all[3].Error, "garbage should not decode as a transaction")
+
+	rows := (&BlockResponse{Txs: all}).TableRows()
+	assert.Equal(t, []string{"0", all[0].TxHash, "", "", "commitment", CommitmentRollupDatasRoot + "=" + all[0].Root}, rows[0])
+
+	filtered := decodeBlockTxs(txs, "astria", DecodedTxFilter{ActionType: "transfer"})
+	require.Len(t, filtered, 1)
+	assert.Equal(t, 2, filtered[0].Index)
+
+	none := decodeBlockTxs(txs, "astria", DecodedTxFilter{ActionType: "bridge_lock"})
+	assert.Empty(t, none)
+}
+
+func TestBlockResponseJSON(t *testing.T) {
+	block := &coretypes.ResultBlock{Block: cmttypes.MakeBlock(5, nil, nil, nil)}
+	res := &BlockResponse{Block: block, Height: 5, Txs: []*BlockTx{}}
+
+	data, err := res.JSON()
+	require.NoError(t, err)
+	raw, err := json.MarshalIndent(block, "", "  ")
+	require.NoError(t, err)
+	assert.Equal(t, raw, data, "the JSON output should be the block as returned by CometBFT")
+
+	data, err = (&DecodedBlockResponse{res}).JSON()
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"txs": []`)
+	assert.Contains(t, string(data), `"height": 5`)
+}
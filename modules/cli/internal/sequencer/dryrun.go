@@ -0,0 +1,146 @@
+package sequencer
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"time"
+
+	txproto "buf.build/gen/go/astria/protocol-apis/protocolbuffers/go/astria/protocol/transaction/v1"
+	"github.com/astriaorg/astria-cli-go/modules/bech32m"
+	"github.com/astriaorg/astria-cli-go/modules/go-sequencer-client/client"
+	log "github.com/sirupsen/logrus"
+)
+
+// DryRun builds and signs a transaction containing opts.Actions without
+// broadcasting it. The fees of each action are queried from the sequencer
+// and, together with the amounts the actions move, compared against the
+// signer's balances.
+func DryRun(opts DryRunOpts) (*DryRunResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	log.Debug("Creating CometBFT client with url: ", opts.SequencerURL)
+	c, err := client.NewClient(opts.SequencerURL)
+	if err != nil {
+		log.WithError(err).Error("Error creating sequencer client")
+		return &DryRunResponse{}, err
+	}
+
+	signer := client.NewSigner(opts.FromKey)
+	addr, err := bech32m.EncodeFromBytes(opts.AddressPrefix, signer.Address())
+	if err != nil {
+		log.WithError(err).Error("Failed to encode address")
+		return &DryRunResponse{}, err
+	}
+	nonce, err := c.GetNonce(ctx, addr.String())
+	if err != nil {
+		log.WithError(err).Error("Error getting nonce")
+		return &DryRunResponse{}, err
+	}
+	log.Debugf("Nonce: %v", nonce)
+
+	// sign the transaction to make sure it is valid, but never broadcast it
+	signed, err := signer.SignTransaction(&txproto.TransactionBody{
+		Params: &txproto.TransactionParams{
+			ChainId: opts.SequencerChainID,
+			Nonce:   nonce,
+		},
+		Actions: opts.Actions,
+	})
+	if err != nil {
+		log.WithError(err).Error("Error signing transaction")
+		return &DryRunResponse{}, err
+	}
+	hash, err := TxHash(signed)
+	if err != nil {
+		log.WithError(err).Error("Error hashing transaction")
+		return &DryRunResponse{}, err
+	}
+
+	costs := map[string]*DryRunCost{}
+	cost := func(denom string) *DryRunCost {
+		if _, ok := costs[denom]; !ok {
+			costs[denom] = &DryRunCost{
+				Denom:   denom,
+				Amount:  big.NewInt(0),
+				Fees:    big.NewInt(0),
+				Total:   big.NewInt(0),
+				Balance: big.NewInt(0),
+			}
+		}
+		return costs[denom]
+	}
+
+	actions := make([]*DryRunAction, len(opts.Actions))
+	for i, action := range opts.Actions {
+		fees, err := c.GetTransactionFee(ctx, &txproto.TransactionBody{
+			Params:  &txproto.TransactionParams{ChainId: opts.SequencerChainID, Nonce: nonce},
+			Actions: []*txproto.Action{action},
+		})
+		if err != nil {
+			log.WithError(err).Errorf("Error getting fee for action %d", i)
+			return &DryRunResponse{}, err
+		}
+
+		actions[i] = &DryRunAction{
+			Index: i,
+			Type:  DecodeAction(action).Type,
+			Fees:  make([]*Balance, len(fees.Fees)),
+		}
+		for j, fee := range fees.Fees {
+			actions[i].Fees[j] = &Balance{Denom: fee.Asset, Balance: fee.Fee}
+			cost(fee.Asset).Fees.Add(cost(fee.Asset).Fees, fee.Fee)
+		}
+		if spend := actionSpend(action); spend != nil {
+			actions[i].Amount = spend
+			cost(spend.Denom).Amount.Add(cost(spend.Denom).Amount, spend.Balance)
+		}
+	}
+
+	balances, err := c.GetBalances(ctx, addr.String())
+	if err != nil {
+		log.WithError(err).Error("Error getting balances")
+		return &DryRunResponse{}, err
+	}
+	for _, balance := range balances {
+		if cst, ok := costs[balance.Denom]; ok {
+			cst.Balance = balance.Balance
+		}
+	}
+
+	res := &DryRunResponse{
+		From:       addr.String(),
+		Nonce:      nonce,
+		TxHash:     hash,
+		Actions:    actions,
+		Costs:      make([]*DryRunCost, 0, len(costs)),
+		Sufficient: true,
+	}
+	for _, cst := range costs {
+		cst.Total.Add(cst.Amount, cst.Fees)
+		cst.Sufficient = cst.Balance.Cmp(cst.Total) >= 0
+		res.Sufficient = res.Sufficient && cst.Sufficient
+		res.Costs = append(res.Costs, cst)
+	}
+	sort.Slice(res.Costs, func(i, j int) bool {
+		return res.Costs[i].Denom < res.Costs[j].Denom
+	})
+
+	return res, nil
+}
+
+// actionSpend returns the amount an action moves out of the signer's
+// account, excluding fees, or nil if it does not move any funds.
+func actionSpend(action *txproto.Action) *Balance {
+	switch v := action.Value.(type) {
+	case *txproto.Action_Transfer:
+		return &Balance{Denom: v.Transfer.GetAsset(), Balance: client.ProtoU128ToBigInt(v.Transfer.GetAmount())}
+	case *txproto.Action_BridgeLock:
+		return &Balance{Denom: v.BridgeLock.GetAsset(), Balance: client.ProtoU128ToBigInt(v.BridgeLock.GetAmount())}
+	case *txproto.Action_Ics20Withdrawal:
+		return &Balance{Denom: v.Ics20Withdrawal.GetDenom(), Balance: client.ProtoU128ToBigInt(v.Ics20Withdrawal.GetAmount())}
+	default:
+		return nil
+	}
+}
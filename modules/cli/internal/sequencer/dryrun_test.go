@@ -0,0 +1,79 @@
+package sequencer
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"testing"
+
+	primproto "buf.build/gen/go/astria/primitives/protocolbuffers/go/astria/primitive/v1"
+	accountsproto "buf.build/gen/go/astria/protocol-apis/protocolbuffers/go/astria/protocol/accounts/v1"
+	feesproto "buf.build/gen/go/astria/protocol-apis/protocolbuffers/go/astria/protocol/fees/v1"
+	txproto "buf.build/gen/go/astria/protocol-apis/protocolbuffers/go/astria/protocol/transaction/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestDryRun(t *testing.T) {
+	keyBytes, err := hex.DecodeString(testPrivKey)
+	require.NoError(t, err)
+	opts := DryRunOpts{
+		AddressPrefix:    "astria",
+		FromKey:          ed25519.NewKeyFromSeed(keyBytes),
+		SequencerChainID: "test-chain",
+		Actions: []*txproto.Action{
+			TransferAction(TransferOpts{
+				ToAddress: &primproto.Address{Bech32M: testOtherAddress},
+				Amount:    &primproto.Uint128{Lo: 1000},
+				Asset:     "ntia",
+				FeeAsset:  "ntia",
+			}),
+			InitBridgeAccountAction(InitBridgeOpts{
+				RollupName: "test-rollup",
+				Asset:      "ntia",
+				FeeAsset:   "ntia",
+			}),
+		},
+	}
+
+	tests := []struct {
+		name       string
+		balance    uint64
+		sufficient bool
+	}{
+		{"sufficient", 1024, true},
+		{"insufficient", 1010, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newTestQueryServer(t, map[string]proto.Message{
+				"accounts/nonce/" + testAddress: &accountsproto.NonceResponse{Nonce: 3},
+				"accounts/balance/" + testAddress: &accountsproto.BalanceResponse{Balances: []*accountsproto.AssetBalance{
+					{Denom: "ntia", Balance: &primproto.Uint128{Lo: tt.balance}},
+				}},
+				"transaction/fee": &feesproto.TransactionFeeResponse{Fees: []*feesproto.TransactionFee{
+					{Asset: "ntia", Fee: &primproto.Uint128{Lo: 12}},
+				}},
+			}, nil)
+			defer server.Close()
+
+			opts.SequencerURL = server.URL
+			res, err := DryRun(opts)
+			require.NoError(t, err)
+			assert.Equal(t, testAddress, res.From)
+			assert.Equal(t, uint32(3), res.Nonce)
+			assert.Len(t, res.TxHash, 64)
+
+			require.Len(t, res.Actions, 2)
+			assert.Equal(t, "transfer", res.Actions[0].Type)
+			assert.Equal(t, "1000", res.Actions[0].Amount.Balance.String())
+			assert.Nil(t, res.Actions[1].Amount, "init bridge account should not move funds")
+
+			require.Len(t, res.Costs, 1)
+			assert.Equal(t, "24", res.Costs[0].Fees.String())
+			assert.Equal(t, "1024", res.Costs[0].Total.String())
+			assert.Equal(t, tt.sufficient, res.Costs[0].Sufficient)
+			assert.Equal(t, tt.sufficient, res.Sufficient)
+		})
+	}
+}
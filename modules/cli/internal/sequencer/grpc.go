@@ -0,0 +1,181 @@
+package sequencer
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	primproto "buf.build/gen/go/astria/primitives/protocolbuffers/go/astria/primitive/v1"
+	"github.com/astriaorg/astria-cli-go/modules/go-sequencer-client/client"
+	sequencerblockv1 "github.com/astriaorg/astria-cli-go/modules/go-sequencer-client/proto/astria/sequencerblock/v1"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+)
+
+// GetSequencerBlock returns the sequencer block at opts.Height as served by
+// the sequencer's gRPC SequencerService. If opts.Rollups is set, the block is
+// filtered to the transactions of those rollups.
+func GetSequencerBlock(opts SequencerBlockOpts) (*SequencerBlockResponse, error) {
+	log.Debug("Creating sequencer gRPC client with url: ", opts.SequencerGRPCURL)
+
+	c, err := client.NewGRPCClient(opts.SequencerGRPCURL)
+	if err != nil {
+		log.WithError(err).Error("Error creating sequencer gRPC client")
+		return &SequencerBlockResponse{}, err
+	}
+	defer c.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var block *sequencerblockv1.FilteredSequencerBlock
+	if len(opts.Rollups) == 0 {
+		full, err := c.GetSequencerBlock(ctx, opts.Height)
+		if err != nil {
+			log.WithError(err).Error("Error getting sequencer block")
+			return &SequencerBlockResponse{}, err
+		}
+		block = filteredFromSequencerBlock(full)
+	} else {
+		ids := make([][]byte, 0, len(opts.Rollups))
+		for _, rollup := range opts.Rollups {
+			ids = append(ids, rollupIDFromNameOrHex(rollup).Inner)
+		}
+		block, err = c.GetFilteredSequencerBlock(ctx, opts.Height, ids)
+		if err != nil {
+			log.WithError(err).Error("Error getting filtered sequencer block")
+			return &SequencerBlockResponse{}, err
+		}
+	}
+
+	return sequencerBlockResponse(block), nil
+}
+
+// rollupIDFromNameOrHex returns the rollup ID given as 0x prefixed hex, or
+// the ID of the rollup with the given name otherwise.
+func rollupIDFromNameOrHex(rollup string) *primproto.RollupId {
+	if strings.HasPrefix(rollup, "0x") {
+		if id, err := hex.DecodeString(rollup[2:]); err == nil && len(id) == 32 {
+			return &primproto.RollupId{Inner: id}
+		}
+	}
+	return rollupIdFromText(rollup)
+}
+
+// filteredFromSequencerBlock converts a full sequencer block into a filtered
+// one containing the transactions of every rollup, so both can be handled
+// alike.
+func filteredFromSequencerBlock(block *sequencerblockv1.SequencerBlock) *sequencerblockv1.FilteredSequencerBlock {
+	ids := make([]*primproto.RollupId, 0, len(block.GetRollupTransactions()))
+	for _, txs := range block.GetRollupTransactions() {
+		ids = append(ids, txs.GetRollupId())
+	}
+	return &sequencerblockv1.FilteredSequencerBlock{
+		BlockHash:               block.GetBlockHash(),
+		Header:                  block.GetHeader(),
+		RollupTransactions:      block.GetRollupTransactions(),
+		RollupTransactionsProof: block.GetRollupTransactionsProof(),
+		AllRollupIds:            ids,
+		RollupIdsProof:          block.GetRollupIdsProof(),
+	}
+}
+
+// sequencerBlockResponse converts a sequencer block into a
+// SequencerBlockResponse, decoding the rollup transactions.
+func sequencerBlockResponse(block *sequencerblockv1.FilteredSequencerBlock) *SequencerBlockResponse {
+	header := block.GetHeader()
+	resp := &SequencerBlockResponse{
+		Block:                   block,
+		Height:                  header.GetHeight(),
+		ChainID:                 header.GetChainId(),
+		BlockHash:               hex.EncodeToString(block.GetBlockHash()),
+		DataHash:                hex.EncodeToString(header.GetDataHash()),
+		ProposerAddress:         hex.EncodeToString(header.GetProposerAddress()),
+		RollupTransactionsRoot:  hex.EncodeToString(header.GetRollupTransactionsRoot()),
+		RollupTransactionsProof: merkleProof(block.GetRollupTransactionsProof()),
+		RollupIDsProof:          merkleProof(block.GetRollupIdsProof()),
+		RollupIDs:               []string{},
+		Rollups:                 []*SequencerBlockRollup{},
+	}
+	if header.GetTime() != nil {
+		resp.Time = header.GetTime().AsTime().UTC().Format(time.RFC3339Nano)
+	}
+	for _, id := range block.GetAllRollupIds() {
+		resp.RollupIDs = append(resp.RollupIDs, rollupIDString(id))
+	}
+
+	for _, txs := range block.GetRollupTransactions() {
+		rollup := &SequencerBlockRollup{
+			RollupID:     rollupIDString(txs.GetRollupId()),
+			Proof:        merkleProof(txs.GetProof()),
+			Transactions: make([]*RollupTx, 0, len(txs.GetTransactions())),
+		}
+		for i, data := range txs.GetTransactions() {
+			rollup.Transactions = append(rollup.Transactions, decodeRollupTx(i, data))
+		}
+		resp.Rollups = append(resp.Rollups, rollup)
+	}
+	return resp
+}
+
+// decodeRollupTx decodes a protobuf encoded RollupData into a RollupTx.
+func decodeRollupTx(index int, data []byte) *RollupTx {
+	tx := &RollupTx{
+		Index: index,
+		Data:  hex.EncodeToString(data),
+		DecodedAction: DecodedAction{
+			Fields: map[string]string{},
+		},
+	}
+
+	rollupData := &sequencerblockv1.RollupData{}
+	if err := proto.Unmarshal(data, rollupData); err != nil {
+		tx.Error = fmt.Sprintf("failed to decode rollup data: %v", err)
+		return tx
+	}
+
+	set := func(key, value string) {
+		if value != "" {
+			tx.Fields[key] = value
+		}
+	}
+	switch v := rollupData.GetValue().(type) {
+	case *sequencerblockv1.RollupData_SequencedData:
+		tx.Type = "sequenced_data"
+		set("dataSize", strconv.Itoa(len(v.SequencedData)))
+	case *sequencerblockv1.RollupData_Deposit:
+		tx.Type = "deposit"
+		d := v.Deposit
+		set("bridgeAddress", addressString(d.GetBridgeAddress()))
+		set("amount", amountString(d.GetAmount()))
+		set("asset", d.GetAsset())
+		set("destinationChainAddress", d.GetDestinationChainAddress())
+		set("sourceTransactionId", d.GetSourceTransactionId().GetInner())
+		set("sourceActionIndex", strconv.FormatUint(d.GetSourceActionIndex(), 10))
+	default:
+		tx.Error = "rollup data has no value"
+	}
+	return tx
+}
+
+// merkleProof converts a Proof protobuf into a MerkleProof, or returns nil if
+// the proof is unset.
+func merkleProof(proof *primproto.Proof) *MerkleProof {
+	if proof == nil {
+		return nil
+	}
+
+	auditPath := []string{}
+	path := proof.GetAuditPath()
+	for i := 0; i+32 <= len(path); i += 32 {
+		auditPath = append(auditPath, hex.EncodeToString(path[i:i+32]))
+	}
+	return &MerkleProof{
+		LeafIndex: proof.GetLeafIndex(),
+		TreeSize:  proof.GetTreeSize(),
+		AuditPath: auditPath,
+	}
+}
@@ -0,0 +1,130 @@
+package sequencer
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	primproto "buf.build/gen/go/astria/primitives/protocolbuffers/go/astria/primitive/v1"
+	"github.com/astriaorg/astria-cli-go/modules/cli/internal/testutils"
+	sequencerblockv1 "github.com/astriaorg/astria-cli-go/modules/go-sequencer-client/proto/astria/sequencerblock/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// testSequencerService serves blocks with valid commitments to one sequenced
+// data transaction for test-rollup and one deposit for other-rollup.
+type testSequencerService struct {
+	sequencerblockv1.UnimplementedSequencerServiceServer
+	t *testing.T
+	// tamper, if set, modifies the filtered blocks before they are served
+	tamper func(*sequencerblockv1.FilteredSequencerBlock)
+}
+
+func (s *testSequencerService) block(height uint64) *sequencerblockv1.SequencerBlock {
+	sequenced, err := proto.Marshal(&sequencerblockv1.RollupData{
+		Value: &sequencerblockv1.RollupData_SequencedData{SequencedData: []byte("data")},
+	})
+	require.NoError(s.t, err)
+	deposit, err := proto.Marshal(&sequencerblockv1.RollupData{
+		Value: &sequencerblockv1.RollupData_Deposit{Deposit: &sequencerblockv1.Deposit{
+			BridgeAddress:           &primproto.Address{Bech32M: testAddress},
+			Amount:                  &primproto.Uint128{Lo: 100},
+			Asset:                   "nria",
+			DestinationChainAddress: "0xabc",
+		}},
+	})
+	require.NoError(s.t, err)
+
+	// test-rollup's ID sorts before other-rollup's
+	return testutils.NewSequencerBlock(height, []testutils.RollupTxs{
+		{ID: rollupIdFromText("test-rollup").Inner, Txs: [][]byte{sequenced}},
+		{ID: rollupIdFromText("other-rollup").Inner, Txs: [][]byte{deposit}},
+	})
+}
+
+func (s *testSequencerService) GetSequencerBlock(_ context.Context, req *sequencerblockv1.GetSequencerBlockRequest) (*sequencerblockv1.SequencerBlock, error) {
+	return s.block(req.Height), nil
+}
+
+func (s *testSequencerService) GetFilteredSequencerBlock(_ context.Context, req *sequencerblockv1.GetFilteredSequencerBlockRequest) (*sequencerblockv1.FilteredSequencerBlock, error) {
+	block := filteredFromSequencerBlock(s.block(req.Height))
+	var txs []*sequencerblockv1.RollupTransactions
+	for _, rollupTxs := range block.RollupTransactions {
+		for _, id := range req.RollupIds {
+			if proto.Equal(id, rollupTxs.RollupId) {
+				txs = append(txs, rollupTxs)
+			}
+		}
+	}
+	block.RollupTransactions = txs
+	if s.tamper != nil {
+		s.tamper(block)
+	}
+	return block, nil
+}
+
+// newTestSequencerGRPCServer starts a gRPC server with a
+// testSequencerService serving blocks modified by tamper, and returns its URL.
+func newTestSequencerGRPCServer(t *testing.T, tamper func(*sequencerblockv1.FilteredSequencerBlock)) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+	sequencerblockv1.RegisterSequencerServiceServer(server, &testSequencerService{t: t, tamper: tamper})
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	t.Cleanup(server.Stop)
+	return "http://" + lis.Addr().String()
+}
+
+func TestGetSequencerBlock(t *testing.T) {
+	url := newTestSequencerGRPCServer(t, nil)
+
+	block, err := GetSequencerBlock(SequencerBlockOpts{SequencerGRPCURL: url, Height: 42})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(42), block.Height)
+	assert.Equal(t, "test-chain", block.ChainID)
+	assert.Equal(t, strings.Repeat("0", 64), block.BlockHash)
+	assert.Len(t, block.RollupTransactionsProof.AuditPath, 2)
+	assert.Equal(t, uint64(1), block.RollupIDsProof.LeafIndex)
+	assert.Equal(t, []string{rollupIDString(rollupIdFromText("test-rollup")), rollupIDString(rollupIdFromText("other-rollup"))}, block.RollupIDs)
+	require.Len(t, block.Rollups, 2)
+
+	sequenced := block.Rollups[0].Transactions[0]
+	assert.Equal(t, "sequenced_data", sequenced.Type)
+	assert.Equal(t, "4", sequenced.Fields["dataSize"])
+
+	deposit := block.Rollups[1].Transactions[0]
+	assert.Equal(t, "deposit", deposit.Type)
+	assert.Equal(t, "100", deposit.Fields["amount"])
+	assert.Equal(t, testAddress, deposit.Fields["bridgeAddress"])
+}
+
+func TestGetFilteredSequencerBlock(t *testing.T) {
+	url := newTestSequencerGRPCServer(t, nil)
+	otherID := "0x" + rollupIDString(rollupIdFromText("other-rollup"))
+
+	for _, rollup := range []string{"other-rollup", otherID} {
+		t.Run(rollup, func(t *testing.T) {
+			block, err := GetSequencerBlock(SequencerBlockOpts{SequencerGRPCURL: url, Height: 42, Rollups: []string{rollup}})
+			require.NoError(t, err)
+			assert.Len(t, block.RollupIDs, 2, "all rollup IDs should be listed")
+			require.Len(t, block.Rollups, 1)
+			assert.Equal(t, otherID[2:], block.Rollups[0].RollupID)
+
+			rows := block.TableRows()
+			assert.Equal(t, []string{rollupIDString(rollupIdFromText("test-rollup")), "", "filtered", ""}, rows[len(rows)-1])
+		})
+	}
+}
+
+func TestDecodeRollupTxInvalid(t *testing.T) {
+	tx := decodeRollupTx(3, []byte{0xff})
+	assert.Equal(t, 3, tx.Index)
+	assert.NotEmpty(t, tx.Error)
+}
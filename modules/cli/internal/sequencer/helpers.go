@@ -5,6 +5,8 @@ import (
 	"time"
 
 	primproto "buf.build/gen/go/astria/primitives/protocolbuffers/go/astria/primitive/v1"
+	txproto "buf.build/gen/go/astria/protocol-apis/protocolbuffers/go/astria/protocol/transaction/v1"
+	"github.com/astriaorg/astria-cli-go/modules/go-sequencer-client/client"
 )
 
 // rollupIdFromText converts a string to a RollupId protobuf.
@@ -19,3 +21,24 @@ func rollupIdFromText(rollup string) *primproto.RollupId {
 func nowPlusFiveMinutes() uint64 {
 	return uint64(time.Now().UnixNano() + 5*60*1e9)
 }
+
+// actionName returns the name of the action's type as it appears in the
+// Action protobuf, ie. "transfer" or "rollup_data_submission".
+func actionName(action *txproto.Action) string {
+	m := action.ProtoReflect()
+	field := m.WhichOneof(m.Descriptor().Oneofs().ByName("value"))
+	if field == nil {
+		return "unknown"
+	}
+	return string(field.Name())
+}
+
+// broadcastTimeout returns the timeout for a function that queries the
+// sequencer, broadcasts a transaction and waits for it as configured by wait.
+func broadcastTimeout(wait client.WaitOptions) time.Duration {
+	timeout := wait.Timeout
+	if timeout <= 0 {
+		timeout = client.DefaultWaitTimeout
+	}
+	return 10*time.Second + timeout
+}
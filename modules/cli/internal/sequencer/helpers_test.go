@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	primproto "buf.build/gen/go/astria/primitives/protocolbuffers/go/astria/primitive/v1"
+	txproto "buf.build/gen/go/astria/protocol-apis/protocolbuffers/go/astria/protocol/transaction/v1"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -15,3 +16,13 @@ func TestRollupIdFromText(t *testing.T) {
 	}
 	assert.Equal(t, expected, actual)
 }
+
+func TestActionName(t *testing.T) {
+	transfer := &txproto.Action{Value: &txproto.Action_Transfer{Transfer: &txproto.Transfer{}}}
+	assert.Equal(t, "transfer", actionName(transfer))
+
+	submission := &txproto.Action{Value: &txproto.Action_RollupDataSubmission{RollupDataSubmission: &txproto.RollupDataSubmission{}}}
+	assert.Equal(t, "rollup_data_submission", actionName(submission))
+
+	assert.Equal(t, "unknown", actionName(&txproto.Action{}))
+}
@@ -0,0 +1,154 @@
+package sequencer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/astriaorg/astria-cli-go/modules/go-sequencer-client/client"
+	log "github.com/sirupsen/logrus"
+)
+
+// DefaultHistoryConcurrency is the number of blocks fetched at once when
+// scanning for an address's history.
+const DefaultHistoryConcurrency = 8
+
+// GetHistory scans the blocks between opts.FromHeight and opts.ToHeight
+// (inclusive) and returns every action involving opts.Address, ie. every
+// action of transactions it signed and every other action referencing it.
+func GetHistory(opts HistoryOpts) (*HistoryResponse, error) {
+	log.Debug("Creating CometBFT client with url: ", opts.SequencerURL)
+
+	c, err := client.NewClient(opts.SequencerURL)
+	if err != nil {
+		log.WithError(err).Error("Error creating sequencer client")
+		return &HistoryResponse{}, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	toHeight := opts.ToHeight
+	if toHeight == 0 {
+		heightCtx, heightCancel := context.WithTimeout(ctx, 10*time.Second)
+		defer heightCancel()
+		toHeight, err = c.GetBlockHeight(heightCtx)
+		if err != nil {
+			log.WithError(err).Error("Error getting blockheight")
+			return &HistoryResponse{}, err
+		}
+	}
+	if opts.FromHeight < 1 || opts.FromHeight > toHeight {
+		err := fmt.Errorf("invalid height range %d to %d", opts.FromHeight, toHeight)
+		log.WithError(err).Error("Error scanning blocks")
+		return &HistoryResponse{}, err
+	}
+
+	concurrency := opts.Concurrency
+	if concurrency < 1 {
+		concurrency = DefaultHistoryConcurrency
+	}
+
+	filter := DecodedTxFilter{Address: opts.Address}
+	entries := []*HistoryEntry{}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	var firstErr error
+	sem := make(chan struct{}, concurrency)
+
+	for height := opts.FromHeight; height <= toHeight; height++ {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(height int64) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			blockCtx, blockCancel := context.WithTimeout(ctx, 10*time.Second)
+			defer blockCancel()
+			block, err := c.GetBlock(blockCtx, &height)
+			if err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = fmt.Errorf("failed to get block %d: %w", height, err)
+					cancel()
+				}
+				mu.Unlock()
+				return
+			}
+
+			found := historyEntries(height, decodeBlockTxs(block.Block.Txs, opts.AddressPrefix, filter), opts.Address)
+			mu.Lock()
+			entries = append(entries, found...)
+			mu.Unlock()
+			log.Debug("Scanned block at height: ", height)
+		}(height)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		log.WithError(firstErr).Error("Error scanning blocks")
+		return &HistoryResponse{}, firstErr
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].Height != entries[j].Height {
+			return entries[i].Height < entries[j].Height
+		}
+		if entries[i].TxIndex != entries[j].TxIndex {
+			return entries[i].TxIndex < entries[j].TxIndex
+		}
+		return entries[i].ActionIndex < entries[j].ActionIndex
+	})
+
+	return &HistoryResponse{
+		Address:    opts.Address,
+		FromHeight: opts.FromHeight,
+		ToHeight:   toHeight,
+		Entries:    entries,
+	}, nil
+}
+
+// historyEntries returns an entry for each action of the given transactions
+// that involves address. Every action of a transaction signed by address is
+// included, other actions are included if they reference address.
+func historyEntries(height int64, txs []*BlockTx, address string) []*HistoryEntry {
+	entries := []*HistoryEntry{}
+	for _, tx := range txs {
+		for i, action := range tx.Actions {
+			roles := []string{}
+			if tx.From == address {
+				roles = append(roles, "signer")
+			}
+			keys := make([]string, 0, len(action.Fields))
+			for k, v := range action.Fields {
+				if v == address {
+					keys = append(keys, k)
+				}
+			}
+			sort.Strings(keys)
+			roles = append(roles, keys...)
+			if len(roles) == 0 {
+				continue
+			}
+
+			entries = append(entries, &HistoryEntry{
+				Height:      height,
+				TxIndex:     tx.Index,
+				TxHash:      tx.TxHash,
+				ActionIndex: i,
+				Roles:       roles,
+				Action:      action,
+			})
+		}
+	}
+	return entries
+}
@@ -0,0 +1,115 @@
+package sequencer
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestBlockServer serves CometBFT `block` requests for heights 1 to
+// latest, where the block at txHeight contains txs.
+func newTestBlockServer(t *testing.T, latest, txHeight int64, txs cmttypes.Txs) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req rpctypes.RPCRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		var params struct {
+			Height *string `json:"height"`
+		}
+		require.NoError(t, json.Unmarshal(req.Params, &params))
+		height := latest
+		if params.Height != nil {
+			h, err := strconv.ParseInt(*params.Height, 10, 64)
+			require.NoError(t, err)
+			height = h
+		}
+
+		var blockTxs cmttypes.Txs
+		if height == txHeight {
+			blockTxs = txs
+		}
+		result, err := cmtjson.Marshal(&coretypes.ResultBlock{Block: cmttypes.MakeBlock(height, blockTxs, nil, nil)})
+		require.NoError(t, err)
+		require.NoError(t, json.NewEncoder(w).Encode(rpctypes.RPCResponse{JSONRPC: "2.0", ID: req.ID, Result: result}))
+	}))
+}
+
+func TestGetHistory(t *testing.T) {
+	_, data := signedTestTx(t)
+	server := newTestBlockServer(t, 20, 12, cmttypes.Txs{make([]byte, 32), data})
+	defer server.Close()
+
+	history, err := GetHistory(HistoryOpts{
+		AddressPrefix: "astria",
+		SequencerURL:  server.URL,
+		Address:       testOtherAddress,
+		FromHeight:    5,
+		Concurrency:   3,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(20), history.ToHeight, "to height should default to the latest block")
+	require.Len(t, history.Entries, 1)
+	assert.Equal(t, int64(12), history.Entries[0].Height)
+	assert.Equal(t, 1, history.Entries[0].TxIndex)
+	assert.Equal(t, []string{"to"}, history.Entries[0].Roles)
+	assert.Equal(t, "transfer", history.Entries[0].Action.Type)
+
+	signer, err := GetHistory(HistoryOpts{
+		AddressPrefix: "astria",
+		SequencerURL:  server.URL,
+		Address:       testAddress,
+		FromHeight:    1,
+		ToHeight:      11,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, signer.Entries, "blocks outside the range should not be scanned")
+}
+
+func TestGetHistoryInvalidRange(t *testing.T) {
+	server := newTestBlockServer(t, 20, 0, nil)
+	defer server.Close()
+
+	_, err := GetHistory(HistoryOpts{
+		SequencerURL: server.URL,
+		Address:      testAddress,
+		FromHeight:   15,
+		ToHeight:     10,
+	})
+	assert.Error(t, err)
+}
+
+func TestHistoryEntries(t *testing.T) {
+	txs := []*BlockTx{
+		{Index: 0, DecodedTxResponse: DecodedTxResponse{
+			From: testAddress,
+			Actions: []DecodedAction{
+				{Type: "transfer", Fields: map[string]string{"to": testOtherAddress}},
+				{Type: "bridge_sudo_change", Fields: map[string]string{"bridgeAddress": testAddress, "newSudoAddress": testAddress}},
+			},
+		}},
+		{Index: 1, DecodedTxResponse: DecodedTxResponse{
+			From: testOtherAddress,
+			Actions: []DecodedAction{
+				{Type: "transfer", Fields: map[string]string{"to": testAddress}},
+				{Type: "transfer", Fields: map[string]string{"to": testOtherAddress}},
+			},
+		}},
+	}
+
+	entries := historyEntries(7, txs, testAddress)
+	require.Len(t, entries, 3)
+	assert.Equal(t, []string{"signer"}, entries[0].Roles)
+	assert.Equal(t, []string{"signer", "bridgeAddress", "newSudoAddress"}, entries[1].Roles)
+	assert.Equal(t, []string{"to"}, entries[2].Roles)
+	assert.Equal(t, 1, entries[2].TxIndex)
+	assert.Equal(t, 0, entries[2].ActionIndex)
+}
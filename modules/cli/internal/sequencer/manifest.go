@@ -0,0 +1,509 @@
+package sequencer
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	primproto "buf.build/gen/go/astria/primitives/protocolbuffers/go/astria/primitive/v1"
+	txproto "buf.build/gen/go/astria/protocol-apis/protocolbuffers/go/astria/protocol/transaction/v1"
+	abci "buf.build/gen/go/astria/protocol-apis/protocolbuffers/go/astria_vendored/tendermint/abci"
+	crypto "buf.build/gen/go/astria/protocol-apis/protocolbuffers/go/astria_vendored/tendermint/crypto"
+	"github.com/astriaorg/astria-cli-go/modules/bech32m"
+	"github.com/astriaorg/astria-cli-go/modules/go-sequencer-client/client"
+	"github.com/pelletier/go-toml/v2"
+)
+
+// Action types that can be used in a TxManifest.
+const (
+	ManifestActionTransfer             = "transfer"
+	ManifestActionRollupDataSubmission = "rollup_data_submission"
+	ManifestActionInitBridgeAccount    = "init_bridge_account"
+	ManifestActionBridgeLock           = "bridge_lock"
+	ManifestActionBridgeUnlock         = "bridge_unlock"
+	ManifestActionBridgeSudoChange     = "bridge_sudo_change"
+	ManifestActionIcs20Withdrawal      = "ics20_withdrawal"
+	ManifestActionSudoAddressChange    = "sudo_address_change"
+	ManifestActionValidatorUpdate      = "validator_update"
+	ManifestActionIbcRelayerAdd        = "ibc_relayer_add"
+	ManifestActionIbcRelayerRemove     = "ibc_relayer_remove"
+	ManifestActionFeeAssetAdd          = "fee_asset_add"
+	ManifestActionFeeAssetRemove       = "fee_asset_remove"
+)
+
+// TxManifest describes a list of actions to be included in a single
+// transaction. It can be loaded from a TOML or JSON file, where each action is
+// an entry of the `actions` array, ie. `[[actions]]` in TOML.
+type TxManifest struct {
+	Actions []ManifestAction `json:"actions" toml:"actions"`
+}
+
+// ManifestAction is a single action in a TxManifest. Type selects the kind of
+// action and determines which of the other fields are used.
+type ManifestAction struct {
+	// Type is the type of the action, ie. one of the ManifestAction* constants
+	Type string `json:"type" toml:"type"`
+
+	// To is the bech32m address of the recipient for transfers, bridge locks
+	// and bridge unlocks
+	To string `json:"to,omitempty" toml:"to,omitempty"`
+	// Amount is the amount in base units
+	Amount string `json:"amount,omitempty" toml:"amount,omitempty"`
+	// Asset is the asset to transfer, lock or withdraw. Defaults to the
+	// default asset when empty.
+	Asset string `json:"asset,omitempty" toml:"asset,omitempty"`
+	// FeeAsset is the asset used to pay the fee for this action. Defaults to
+	// the default fee asset when empty.
+	FeeAsset string `json:"fee_asset,omitempty" toml:"fee_asset,omitempty"`
+	// Memo is an optional memo for bridge unlocks and ICS20 withdrawals
+	Memo string `json:"memo,omitempty" toml:"memo,omitempty"`
+
+	// RollupName is the name of the rollup for rollup data submissions and
+	// bridge account initialization
+	RollupName string `json:"rollup_name,omitempty" toml:"rollup_name,omitempty"`
+	// Data is the hex encoded data for rollup data submissions
+	Data string `json:"data,omitempty" toml:"data,omitempty"`
+
+	// DestinationChainAddress is the address on the destination chain for
+	// bridge locks and ICS20 withdrawals
+	DestinationChainAddress string `json:"destination_chain_address,omitempty" toml:"destination_chain_address,omitempty"`
+	// ReturnAddress is the address refunds are returned to for ICS20
+	// withdrawals. Defaults to the signer's address when empty.
+	ReturnAddress string `json:"return_address,omitempty" toml:"return_address,omitempty"`
+	// SourceChannel is the IBC channel used for ICS20 withdrawals
+	SourceChannel string `json:"source_channel,omitempty" toml:"source_channel,omitempty"`
+	// TimeoutTime is the RFC 3339 time at which an ICS20 withdrawal times
+	// out, ie. "2024-06-01T12:00:00Z". Takes precedence over Timeout.
+	TimeoutTime string `json:"timeout_time,omitempty" toml:"timeout_time,omitempty"`
+	// Timeout is how long after the transaction is created an ICS20
+	// withdrawal times out, ie. "24h". Defaults to the default IBC timeout.
+	Timeout string `json:"timeout,omitempty" toml:"timeout,omitempty"`
+
+	// BridgeAddress is the bridge account for bridge unlocks, bridge sudo
+	// changes and bridged ICS20 withdrawals
+	BridgeAddress string `json:"bridge_address,omitempty" toml:"bridge_address,omitempty"`
+	// RollupBlockNumber is the rollup block that triggered a bridge unlock
+	RollupBlockNumber uint64 `json:"rollup_block_number,omitempty" toml:"rollup_block_number,omitempty"`
+	// RollupWithdrawalEventId identifies the rollup event that triggered a
+	// bridge unlock
+	RollupWithdrawalEventId string `json:"rollup_withdrawal_event_id,omitempty" toml:"rollup_withdrawal_event_id,omitempty"`
+	// SudoAddress is the sudo address for bridge account initialization, or
+	// the new sudo address for bridge sudo changes
+	SudoAddress string `json:"sudo_address,omitempty" toml:"sudo_address,omitempty"`
+	// WithdrawerAddress is the withdrawer address for bridge account
+	// initialization, or the new withdrawer address for bridge sudo changes
+	WithdrawerAddress string `json:"withdrawer_address,omitempty" toml:"withdrawer_address,omitempty"`
+
+	// Address is the address used by sudo address changes and IBC relayer
+	// additions and removals
+	Address string `json:"address,omitempty" toml:"address,omitempty"`
+	// PubKey is the hex encoded ed25519 public key for validator updates
+	PubKey string `json:"pub_key,omitempty" toml:"pub_key,omitempty"`
+	// Power is the new voting power for validator updates
+	Power int64 `json:"power,omitempty" toml:"power,omitempty"`
+}
+
+// LoadTxManifest loads a TxManifest from the given path. The file is decoded
+// as JSON if it has a `.json` extension, and as TOML otherwise.
+func LoadTxManifest(path string) (*TxManifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var manifest TxManifest
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		err = json.Unmarshal(data, &manifest)
+	} else {
+		err = toml.Unmarshal(data, &manifest)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode manifest %s: %w", path, err)
+	}
+	if len(manifest.Actions) == 0 {
+		return nil, fmt.Errorf("manifest %s contains no actions", path)
+	}
+
+	return &manifest, nil
+}
+
+// ManifestDefaults are the values used for manifest action fields that are
+// left empty.
+type ManifestDefaults struct {
+	// Asset is used when an action's asset is empty
+	Asset string
+	// FeeAsset is used when an action's fee asset is empty
+	FeeAsset string
+	// Signer is the bech32m address of the transaction signer. It is used
+	// as the return address for ICS20 withdrawals when none is given.
+	Signer string
+	// IbcTimeout is how long after the transaction is created ICS20
+	// withdrawals time out when they set no timeout. Defaults to five
+	// minutes when zero.
+	IbcTimeout time.Duration
+}
+
+// ToActions converts all actions in the manifest to Action protobufs. An
+// error identifying the offending action is returned if any action is invalid.
+func (m *TxManifest) ToActions(defaults ManifestDefaults) ([]*txproto.Action, error) {
+	actions := make([]*txproto.Action, 0, len(m.Actions))
+	for i, a := range m.Actions {
+		action, err := a.toAction(defaults)
+		if err != nil {
+			return nil, fmt.Errorf("action %d (%s): %w", i, a.Type, err)
+		}
+		actions = append(actions, action)
+	}
+	return actions, nil
+}
+
+// toAction converts a single manifest action to an Action protobuf.
+func (a *ManifestAction) toAction(defaults ManifestDefaults) (*txproto.Action, error) {
+	asset := a.Asset
+	if asset == "" {
+		asset = defaults.Asset
+	}
+	feeAsset := a.FeeAsset
+	if feeAsset == "" {
+		feeAsset = defaults.FeeAsset
+	}
+
+	switch a.Type {
+	case ManifestActionTransfer:
+		to, err := requiredAddress("to", a.To)
+		if err != nil {
+			return nil, err
+		}
+		amount, err := requiredAmount(a.Amount)
+		if err != nil {
+			return nil, err
+		}
+		return &txproto.Action{
+			Value: &txproto.Action_Transfer{
+				Transfer: &txproto.Transfer{
+					To:       to,
+					Amount:   amount,
+					Asset:    asset,
+					FeeAsset: feeAsset,
+				},
+			},
+		}, nil
+
+	case ManifestActionRollupDataSubmission:
+		if a.RollupName == "" {
+			return nil, fmt.Errorf("rollup_name is required")
+		}
+		data, err := hex.DecodeString(strings.TrimPrefix(a.Data, "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("data must be hex encoded: %w", err)
+		}
+		return &txproto.Action{
+			Value: &txproto.Action_RollupDataSubmission{
+				RollupDataSubmission: &txproto.RollupDataSubmission{
+					RollupId: rollupIdFromText(a.RollupName),
+					Data:     data,
+					FeeAsset: feeAsset,
+				},
+			},
+		}, nil
+
+	case ManifestActionInitBridgeAccount:
+		if a.RollupName == "" {
+			return nil, fmt.Errorf("rollup_name is required")
+		}
+		sudo, err := optionalAddress("sudo_address", a.SudoAddress)
+		if err != nil {
+			return nil, err
+		}
+		withdrawer, err := optionalAddress("withdrawer_address", a.WithdrawerAddress)
+		if err != nil {
+			return nil, err
+		}
+		return &txproto.Action{
+			Value: &txproto.Action_InitBridgeAccount{
+				InitBridgeAccount: &txproto.InitBridgeAccount{
+					RollupId:          rollupIdFromText(a.RollupName),
+					Asset:             asset,
+					FeeAsset:          feeAsset,
+					SudoAddress:       sudo,
+					WithdrawerAddress: withdrawer,
+				},
+			},
+		}, nil
+
+	case ManifestActionBridgeLock:
+		to, err := requiredAddress("to", a.To)
+		if err != nil {
+			return nil, err
+		}
+		amount, err := requiredAmount(a.Amount)
+		if err != nil {
+			return nil, err
+		}
+		if a.DestinationChainAddress == "" {
+			return nil, fmt.Errorf("destination_chain_address is required")
+		}
+		return &txproto.Action{
+			Value: &txproto.Action_BridgeLock{
+				BridgeLock: &txproto.BridgeLock{
+					To:                      to,
+					Amount:                  amount,
+					Asset:                   asset,
+					FeeAsset:                feeAsset,
+					DestinationChainAddress: a.DestinationChainAddress,
+				},
+			},
+		}, nil
+
+	case ManifestActionBridgeUnlock:
+		to, err := requiredAddress("to", a.To)
+		if err != nil {
+			return nil, err
+		}
+		bridge, err := requiredAddress("bridge_address", a.BridgeAddress)
+		if err != nil {
+			return nil, err
+		}
+		amount, err := requiredAmount(a.Amount)
+		if err != nil {
+			return nil, err
+		}
+		if a.RollupWithdrawalEventId == "" {
+			return nil, fmt.Errorf("rollup_withdrawal_event_id is required")
+		}
+		return &txproto.Action{
+			Value: &txproto.Action_BridgeUnlock{
+				BridgeUnlock: &txproto.BridgeUnlock{
+					To:                      to,
+					Amount:                  amount,
+					FeeAsset:                feeAsset,
+					Memo:                    a.Memo,
+					BridgeAddress:           bridge,
+					RollupBlockNumber:       a.RollupBlockNumber,
+					RollupWithdrawalEventId: a.RollupWithdrawalEventId,
+				},
+			},
+		}, nil
+
+	case ManifestActionBridgeSudoChange:
+		bridge, err := requiredAddress("bridge_address", a.BridgeAddress)
+		if err != nil {
+			return nil, err
+		}
+		sudo, err := optionalAddress("sudo_address", a.SudoAddress)
+		if err != nil {
+			return nil, err
+		}
+		withdrawer, err := optionalAddress("withdrawer_address", a.WithdrawerAddress)
+		if err != nil {
+			return nil, err
+		}
+		if sudo == nil && withdrawer == nil {
+			return nil, fmt.Errorf("one of sudo_address or withdrawer_address is required")
+		}
+		return &txproto.Action{
+			Value: &txproto.Action_BridgeSudoChange{
+				BridgeSudoChange: &txproto.BridgeSudoChange{
+					BridgeAddress:        bridge,
+					NewSudoAddress:       sudo,
+					NewWithdrawerAddress: withdrawer,
+					FeeAsset:             feeAsset,
+				},
+			},
+		}, nil
+
+	case ManifestActionIcs20Withdrawal:
+		amount, err := requiredAmount(a.Amount)
+		if err != nil {
+			return nil, err
+		}
+		if a.DestinationChainAddress == "" {
+			return nil, fmt.Errorf("destination_chain_address is required")
+		}
+		if a.SourceChannel == "" {
+			return nil, fmt.Errorf("source_channel is required")
+		}
+		returnAddress := a.ReturnAddress
+		if returnAddress == "" {
+			returnAddress = defaults.Signer
+		}
+		ret, err := requiredAddress("return_address", returnAddress)
+		if err != nil {
+			return nil, err
+		}
+		bridge, err := optionalAddress("bridge_address", a.BridgeAddress)
+		if err != nil {
+			return nil, err
+		}
+		timeoutTime, err := a.ics20TimeoutTime(defaults.IbcTimeout)
+		if err != nil {
+			return nil, err
+		}
+		return &txproto.Action{
+			Value: &txproto.Action_Ics20Withdrawal{
+				Ics20Withdrawal: &txproto.Ics20Withdrawal{
+					Amount:                  amount,
+					Denom:                   asset,
+					DestinationChainAddress: a.DestinationChainAddress,
+					ReturnAddress:           ret,
+					TimeoutHeight: &txproto.IbcHeight{
+						RevisionNumber: math.MaxUint64,
+						RevisionHeight: math.MaxUint64,
+					},
+					TimeoutTime:   timeoutTime,
+					SourceChannel: a.SourceChannel,
+					FeeAsset:      feeAsset,
+					Memo:          a.Memo,
+					BridgeAddress: bridge,
+				},
+			},
+		}, nil
+
+	case ManifestActionSudoAddressChange:
+		addr, err := requiredAddress("address", a.Address)
+		if err != nil {
+			return nil, err
+		}
+		return &txproto.Action{
+			Value: &txproto.Action_SudoAddressChange{
+				SudoAddressChange: &txproto.SudoAddressChange{
+					NewAddress: addr,
+				},
+			},
+		}, nil
+
+	case ManifestActionValidatorUpdate:
+		pubKey, err := hex.DecodeString(strings.TrimPrefix(a.PubKey, "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("pub_key must be hex encoded: %w", err)
+		}
+		if len(pubKey) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("pub_key must be %d bytes, got %d", ed25519.PublicKeySize, len(pubKey))
+		}
+		if a.Power < 0 {
+			return nil, fmt.Errorf("power must not be negative")
+		}
+		return &txproto.Action{
+			Value: &txproto.Action_ValidatorUpdate{
+				ValidatorUpdate: &abci.ValidatorUpdate{
+					PubKey: &crypto.PublicKey{
+						Sum: &crypto.PublicKey_Ed25519{
+							Ed25519: pubKey,
+						},
+					},
+					Power: a.Power,
+				},
+			},
+		}, nil
+
+	case ManifestActionIbcRelayerAdd, ManifestActionIbcRelayerRemove:
+		addr, err := requiredAddress("address", a.Address)
+		if err != nil {
+			return nil, err
+		}
+		change := &txproto.IbcRelayerChange{}
+		if a.Type == ManifestActionIbcRelayerAdd {
+			change.Value = &txproto.IbcRelayerChange_Addition{Addition: addr}
+		} else {
+			change.Value = &txproto.IbcRelayerChange_Removal{Removal: addr}
+		}
+		return &txproto.Action{
+			Value: &txproto.Action_IbcRelayerChange{
+				IbcRelayerChange: change,
+			},
+		}, nil
+
+	case ManifestActionFeeAssetAdd, ManifestActionFeeAssetRemove:
+		if a.Asset == "" {
+			return nil, fmt.Errorf("asset is required")
+		}
+		change := &txproto.FeeAssetChange{}
+		if a.Type == ManifestActionFeeAssetAdd {
+			change.Value = &txproto.FeeAssetChange_Addition{Addition: a.Asset}
+		} else {
+			change.Value = &txproto.FeeAssetChange_Removal{Removal: a.Asset}
+		}
+		return &txproto.Action{
+			Value: &txproto.Action_FeeAssetChange{
+				FeeAssetChange: change,
+			},
+		}, nil
+
+	case "":
+		return nil, fmt.Errorf("type is required")
+	default:
+		return nil, fmt.Errorf("unsupported action type")
+	}
+}
+
+// ics20TimeoutTime returns the timeout of an ICS20 withdrawal in unix
+// nanoseconds, from the action's timeout_time or timeout, or from
+// defaultTimeout when neither is set.
+func (a *ManifestAction) ics20TimeoutTime(defaultTimeout time.Duration) (uint64, error) {
+	if a.TimeoutTime != "" {
+		t, err := time.Parse(time.RFC3339, a.TimeoutTime)
+		if err != nil {
+			return 0, fmt.Errorf("timeout_time must be an RFC 3339 time: %w", err)
+		}
+		if !t.After(time.Now()) {
+			return 0, fmt.Errorf("timeout_time %s is in the past", a.TimeoutTime)
+		}
+		return uint64(t.UnixNano()), nil
+	}
+
+	timeout := defaultTimeout
+	if a.Timeout != "" {
+		var err error
+		timeout, err = time.ParseDuration(a.Timeout)
+		if err != nil {
+			return 0, fmt.Errorf("timeout must be a duration, ie. 24h: %w", err)
+		}
+	}
+	if timeout == 0 {
+		return nowPlusFiveMinutes(), nil
+	}
+	if timeout < 0 {
+		return 0, fmt.Errorf("timeout must not be negative")
+	}
+	return uint64(time.Now().Add(timeout).UnixNano()), nil
+}
+
+// requiredAddress validates a bech32m address and converts it to an Address
+// protobuf. The field name is used in error messages.
+func requiredAddress(field string, addr string) (*primproto.Address, error) {
+	if addr == "" {
+		return nil, fmt.Errorf("%s is required", field)
+	}
+	if err := bech32m.Validate(addr); err != nil {
+		return nil, fmt.Errorf("%s is invalid: %w", field, err)
+	}
+	return &primproto.Address{Bech32M: addr}, nil
+}
+
+// optionalAddress is like requiredAddress, but returns nil without an error
+// when the address is empty.
+func optionalAddress(field string, addr string) (*primproto.Address, error) {
+	if addr == "" {
+		return nil, nil
+	}
+	return requiredAddress(field, addr)
+}
+
+// requiredAmount parses a base 10 amount into a Uint128 protobuf.
+func requiredAmount(amount string) (*primproto.Uint128, error) {
+	if amount == "" {
+		return nil, fmt.Errorf("amount is required")
+	}
+	i, ok := new(big.Int).SetString(amount, 10)
+	if !ok {
+		return nil, fmt.Errorf("amount is not a base 10 integer: %s", amount)
+	}
+	return client.BigIntToProtoU128(i)
+}
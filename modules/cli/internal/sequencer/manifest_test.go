@@ -0,0 +1,193 @@
+package sequencer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	txproto "buf.build/gen/go/astria/protocol-apis/protocolbuffers/go/astria/protocol/transaction/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testAddress = "astria1rsxyjrcm255ds9euthjx6yc3vrjt9sxrm9cfgm"
+const testOtherAddress = "astria1xnlvg0rle2u6auane79t4p27g8hxnj36ja960z"
+
+var testDefaults = ManifestDefaults{
+	Asset:    "ntia",
+	FeeAsset: "nria",
+	Signer:   testAddress,
+}
+
+func writeManifest(t *testing.T, name string, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	return path
+}
+
+func TestLoadTxManifestTOML(t *testing.T) {
+	path := writeManifest(t, "actions.toml", `
+[[actions]]
+type = "transfer"
+to = "`+testOtherAddress+`"
+amount = "18446744073709551616"
+
+[[actions]]
+type = "rollup_data_submission"
+rollup_name = "steezeburger"
+data = "0xdeadbeef"
+fee_asset = "utia"
+
+[[actions]]
+type = "fee_asset_add"
+asset = "utia"
+`)
+
+	manifest, err := LoadTxManifest(path)
+	require.NoError(t, err)
+	require.Len(t, manifest.Actions, 3)
+
+	actions, err := manifest.ToActions(testDefaults)
+	require.NoError(t, err)
+	require.Len(t, actions, 3)
+
+	transfer := actions[0].GetTransfer()
+	require.NotNil(t, transfer)
+	assert.Equal(t, testOtherAddress, transfer.To.Bech32M)
+	assert.Equal(t, uint64(0), transfer.Amount.Lo)
+	assert.Equal(t, uint64(1), transfer.Amount.Hi)
+	assert.Equal(t, "ntia", transfer.Asset)
+	assert.Equal(t, "nria", transfer.FeeAsset)
+
+	submission := actions[1].GetRollupDataSubmission()
+	require.NotNil(t, submission)
+	assert.Equal(t, rollupIdFromText("steezeburger"), submission.RollupId)
+	assert.Equal(t, []byte{0xde, 0xad, 0xbe, 0xef}, submission.Data)
+	assert.Equal(t, "utia", submission.FeeAsset)
+
+	feeAssetChange := actions[2].GetFeeAssetChange()
+	require.NotNil(t, feeAssetChange)
+	assert.Equal(t, "utia", feeAssetChange.GetAddition())
+}
+
+func TestLoadTxManifestJSON(t *testing.T) {
+	path := writeManifest(t, "actions.json", `{
+  "actions": [
+    {"type": "ics20_withdrawal", "amount": "10", "destination_chain_address": "celestia1abc", "source_channel": "channel-0"},
+    {"type": "validator_update", "pub_key": "88787e29db8d5247c6adfac9909b56e6b2705c3120b2e3885e8ec8aa416a10f1", "power": 10},
+    {"type": "ibc_relayer_remove", "address": "`+testOtherAddress+`"}
+  ]
+}`)
+
+	manifest, err := LoadTxManifest(path)
+	require.NoError(t, err)
+
+	actions, err := manifest.ToActions(testDefaults)
+	require.NoError(t, err)
+	require.Len(t, actions, 3)
+
+	withdrawal := actions[0].GetIcs20Withdrawal()
+	require.NotNil(t, withdrawal)
+	assert.Equal(t, "ntia", withdrawal.Denom)
+	assert.Equal(t, testAddress, withdrawal.ReturnAddress.Bech32M, "return address should default to the signer")
+	assert.Equal(t, "channel-0", withdrawal.SourceChannel)
+
+	update := actions[1].GetValidatorUpdate()
+	require.NotNil(t, update)
+	assert.Equal(t, int64(10), update.Power)
+	assert.Len(t, update.PubKey.GetEd25519(), 32)
+
+	relayerChange := actions[2].GetIbcRelayerChange()
+	require.NotNil(t, relayerChange)
+	assert.Equal(t, testOtherAddress, relayerChange.GetRemoval().Bech32M)
+}
+
+func TestLoadTxManifestEmpty(t *testing.T) {
+	path := writeManifest(t, "actions.toml", "")
+	_, err := LoadTxManifest(path)
+	assert.Error(t, err)
+}
+
+func TestManifestToActionsInvalid(t *testing.T) {
+	testCases := []struct {
+		name   string
+		action ManifestAction
+	}{
+		{"MissingType", ManifestAction{}},
+		{"UnknownType", ManifestAction{Type: "mint"}},
+		{"TransferInvalidAddress", ManifestAction{Type: ManifestActionTransfer, To: "astria1notanaddress", Amount: "1"}},
+		{"TransferMissingAmount", ManifestAction{Type: ManifestActionTransfer, To: testOtherAddress}},
+		{"TransferNegativeAmount", ManifestAction{Type: ManifestActionTransfer, To: testOtherAddress, Amount: "-1"}},
+		{"SubmissionInvalidData", ManifestAction{Type: ManifestActionRollupDataSubmission, RollupName: "rollup", Data: "xyz"}},
+		{"BridgeSudoChangeNoAddresses", ManifestAction{Type: ManifestActionBridgeSudoChange, BridgeAddress: testOtherAddress}},
+		{"ValidatorUpdateShortKey", ManifestAction{Type: ManifestActionValidatorUpdate, PubKey: "abcd", Power: 1}},
+		{"FeeAssetAddMissingAsset", ManifestAction{Type: ManifestActionFeeAssetAdd}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			manifest := &TxManifest{Actions: []ManifestAction{tc.action}}
+			_, err := manifest.ToActions(testDefaults)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestManifestIcs20Timeout(t *testing.T) {
+	timeoutTime := time.Now().Add(48 * time.Hour).UTC().Truncate(time.Second)
+	withdrawal := func(a ManifestAction, defaults ManifestDefaults) (*txproto.Ics20Withdrawal, error) {
+		a.Type = ManifestActionIcs20Withdrawal
+		a.Amount = "10"
+		a.DestinationChainAddress = "celestia1abc"
+		a.SourceChannel = "channel-0"
+		manifest := TxManifest{Actions: []ManifestAction{a}}
+		actions, err := manifest.ToActions(defaults)
+		if err != nil {
+			return nil, err
+		}
+		return actions[0].GetIcs20Withdrawal(), nil
+	}
+	within := func(t *testing.T, want time.Duration, got uint64) {
+		assert.InDelta(t, time.Now().Add(want).UnixNano(), int64(got), float64(time.Minute))
+	}
+
+	t.Run("default", func(t *testing.T) {
+		w, err := withdrawal(ManifestAction{}, testDefaults)
+		require.NoError(t, err)
+		within(t, 5*time.Minute, w.TimeoutTime)
+	})
+
+	t.Run("default ibc timeout", func(t *testing.T) {
+		defaults := testDefaults
+		defaults.IbcTimeout = 24 * time.Hour
+		w, err := withdrawal(ManifestAction{}, defaults)
+		require.NoError(t, err)
+		within(t, 24*time.Hour, w.TimeoutTime)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		w, err := withdrawal(ManifestAction{Timeout: "2h"}, testDefaults)
+		require.NoError(t, err)
+		within(t, 2*time.Hour, w.TimeoutTime)
+	})
+
+	t.Run("timeout time", func(t *testing.T) {
+		w, err := withdrawal(ManifestAction{TimeoutTime: timeoutTime.Format(time.RFC3339), Timeout: "2h"}, testDefaults)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(timeoutTime.UnixNano()), w.TimeoutTime)
+	})
+
+	for name, a := range map[string]ManifestAction{
+		"past timeout time":    {TimeoutTime: "2020-01-01T00:00:00Z"},
+		"invalid timeout time": {TimeoutTime: "tomorrow"},
+		"invalid timeout":      {Timeout: "a day"},
+		"negative timeout":     {Timeout: "-1h"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := withdrawal(a, testDefaults)
+			assert.Error(t, err)
+		})
+	}
+}
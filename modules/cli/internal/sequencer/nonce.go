@@ -0,0 +1,83 @@
+package sequencer
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/astriaorg/astria-cli-go/modules/go-sequencer-client/client"
+	log "github.com/sirupsen/logrus"
+)
+
+// nonceStateDir returns the directory the nonces handed out for each address
+// are persisted in, so that concurrent astria-go processes signing with the
+// same key use distinct nonces.
+var nonceStateDir = func() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(homeDir, ".astria", "nonces"), nil
+}
+
+// reserveNonce reserves count consecutive nonces for addr. If useManager is
+// set, they are reserved with the nonce manager, so that concurrent astria-go
+// processes signing with the same key use distinct nonces. Reserved nonces are
+// considered lost if the sequencer's nonce does not advance for well over the
+// wait timeout. Otherwise, or if the nonce manager cannot be used, the
+// sequencer's nonce is returned in a reservation that is not tracked.
+func reserveNonce(ctx context.Context, c *client.Client, chainID string, addr string, count uint32, wait client.WaitOptions, useManager bool) (*client.NonceReservation, error) {
+	return reserveNonceFrom(ctx, c, chainID, addr, 0, count, wait, useManager)
+}
+
+// reserveNonceFrom is like reserveNonce, but the reserved nonces start no
+// lower than from.
+func reserveNonceFrom(ctx context.Context, c *client.Client, chainID string, addr string, from uint32, count uint32, wait client.WaitOptions, useManager bool) (*client.NonceReservation, error) {
+	if useManager {
+		reservation, err := reserveManagedNonce(ctx, c, chainID, addr, from, count, wait)
+		if err == nil {
+			log.Debugf("Nonce: %v", reservation.Nonce)
+			return reservation, nil
+		}
+		if errors.Is(err, errors.ErrUnsupported) {
+			log.WithError(err).Debug("Nonce manager is not supported on this platform, using the sequencer's nonce")
+		} else {
+			log.WithError(err).Warn("Error using nonce manager, falling back to the sequencer's nonce")
+		}
+	}
+
+	nonce, err := c.GetNonce(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	if nonce < from {
+		nonce = from
+	}
+	log.Debugf("Nonce: %v", nonce)
+	return &client.NonceReservation{Nonce: nonce, Count: count}, nil
+}
+
+// reserveManagedNonce reserves nonces with the nonce manager persisted under
+// nonceStateDir.
+func reserveManagedNonce(ctx context.Context, c *client.Client, chainID string, addr string, from uint32, count uint32, wait client.WaitOptions) (*client.NonceReservation, error) {
+	dir, err := nonceStateDir()
+	if err != nil {
+		return nil, err
+	}
+	manager := client.NewNonceManager(c, dir)
+	manager.StaleAfter = nonceStaleAfter(wait)
+	return manager.ReserveFrom(ctx, chainID, addr, from, count)
+}
+
+// nonceStaleAfter returns how long reserved nonces may go unused before the
+// nonce manager considers them lost: ten times the wait timeout, and at least
+// the default.
+func nonceStaleAfter(wait client.WaitOptions) time.Duration {
+	staleAfter := 10 * wait.Timeout
+	if staleAfter < client.DefaultNonceStaleAfter {
+		staleAfter = client.DefaultNonceStaleAfter
+	}
+	return staleAfter
+}
@@ -0,0 +1,167 @@
+package sequencer
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/astriaorg/astria-cli-go/modules/go-sequencer-client/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// useTestNonceDir persists the nonce manager's state in a temporary
+// directory for the duration of the test.
+func useTestNonceDir(t *testing.T) string {
+	dir := t.TempDir()
+	orig := nonceStateDir
+	nonceStateDir = func() (string, error) { return dir, nil }
+	t.Cleanup(func() { nonceStateDir = orig })
+	return dir
+}
+
+func (s *testBroadcastServer) setNonce(nonce uint32) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nonce = nonce
+}
+
+func TestReserveNonce(t *testing.T) {
+	useTestNonceDir(t)
+	server := newTestBroadcastServer(t, 0)
+	server.nonce = 5
+	defer server.Close()
+
+	c, err := client.NewClient(server.URL)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	first, err := reserveNonce(ctx, c, "test-chain", testAddress, 1, client.WaitOptions{}, true)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(5), first.Nonce)
+
+	batch, err := reserveNonce(ctx, c, "test-chain", testAddress, 3, client.WaitOptions{}, true)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(6), batch.Nonce, "pending nonces should not be handed out again")
+
+	other, err := reserveNonce(ctx, c, "other-chain", testAddress, 1, client.WaitOptions{}, true)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(5), other.Nonce, "nonces should be tracked per chain")
+
+	// releasing the end of the latest reservation returns its nonces
+	require.NoError(t, batch.Release(7))
+	next, err := reserveNonce(ctx, c, "test-chain", testAddress, 1, client.WaitOptions{}, true)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(7), next.Nonce)
+
+	// an older reservation cannot be released once later nonces were handed out
+	require.NoError(t, first.Release(5))
+	later, err := reserveNonce(ctx, c, "test-chain", testAddress, 1, client.WaitOptions{}, true)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(8), later.Nonce)
+
+	// the sequencer's nonce moving past the pending nonces takes precedence
+	server.setNonce(20)
+	synced, err := reserveNonce(ctx, c, "test-chain", testAddress, 1, client.WaitOptions{}, true)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(20), synced.Nonce)
+}
+
+func TestReserveNonceConcurrent(t *testing.T) {
+	useTestNonceDir(t)
+	server := newTestBroadcastServer(t, 0)
+	defer server.Close()
+
+	c, err := client.NewClient(server.URL)
+	require.NoError(t, err)
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	nonces := []uint32{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			reservation, err := reserveNonce(context.Background(), c, "test-chain", testAddress, 1, client.WaitOptions{}, true)
+			assert.NoError(t, err)
+			mu.Lock()
+			nonces = append(nonces, reservation.Nonce)
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	sort.Slice(nonces, func(i, j int) bool { return nonces[i] < nonces[j] })
+	assert.Equal(t, []uint32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, nonces, "concurrent reservations should get distinct nonces")
+}
+
+func TestNonceReservationDone(t *testing.T) {
+	dir := useTestNonceDir(t)
+	server := newTestBroadcastServer(t, 0)
+	server.nonce = 3
+	defer server.Close()
+
+	c, err := client.NewClient(server.URL)
+	require.NoError(t, err)
+	ctx := context.Background()
+	manager := client.NewNonceManager(c, dir)
+
+	reservation, err := manager.Reserve(ctx, "test-chain", testAddress, 1)
+	require.NoError(t, err)
+	reservation.Done(ctx, &coretypes.ResultBroadcastTx{Code: 1}, errors.New("insufficient funds"))
+	retry, err := manager.Reserve(ctx, "test-chain", testAddress, 1)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(3), retry.Nonce, "the nonce of a rejected transaction should be released")
+
+	_, err = manager.Reserve(ctx, "test-chain", testAddress, 4)
+	require.NoError(t, err)
+	retry.Done(ctx, &coretypes.ResultBroadcastTx{Code: 4}, errors.New("invalid nonce"))
+	resynced, err := manager.Reserve(ctx, "test-chain", testAddress, 1)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(3), resynced.Nonce, "a nonce error should resync with the sequencer")
+
+	_, err = manager.Reserve(ctx, "test-chain", testAddress, 4)
+	require.NoError(t, err)
+	resynced.Done(ctx, &coretypes.ResultBroadcastTx{Code: 15}, errors.New("nonce taken"))
+	taken, err := manager.Reserve(ctx, "test-chain", testAddress, 1)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(3), taken.Nonce, "a taken nonce should resync with the sequencer")
+
+	// only the sequencer's nonce error codes resync
+	_, err = manager.Reserve(ctx, "test-chain", testAddress, 4)
+	require.NoError(t, err)
+	taken.Done(ctx, &coretypes.ResultBroadcastTx{Code: 6}, errors.New("insufficient funds to pay the nonce fee"))
+	kept, err := manager.Reserve(ctx, "test-chain", testAddress, 1)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(8), kept.Nonce, "other errors should not resync")
+
+	// a transaction that failed to broadcast without a response releases its
+	// nonce, unless the sequencer's nonce shows it was used
+	kept.Done(ctx, nil, errors.New("connection refused"))
+	released, err := manager.Reserve(ctx, "test-chain", testAddress, 1)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(8), released.Nonce, "an unused nonce should be released after a failed broadcast")
+	server.setNonce(9)
+	released.Done(ctx, nil, errors.New("connection reset"))
+	used, err := manager.Reserve(ctx, "test-chain", testAddress, 1)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(9), used.Nonce, "a used nonce should not be released")
+
+	// nonces reserved ahead of a sequencer nonce that does not move are
+	// eventually considered lost
+	manager.StaleAfter = 10 * time.Millisecond
+	time.Sleep(20 * time.Millisecond)
+	stale, err := manager.Reserve(ctx, "test-chain", testAddress, 1)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(9), stale.Nonce)
+}
+
+func TestNonceStaleAfter(t *testing.T) {
+	assert.Equal(t, client.DefaultNonceStaleAfter, nonceStaleAfter(client.WaitOptions{}))
+	assert.Equal(t, client.DefaultNonceStaleAfter, nonceStaleAfter(client.WaitOptions{Timeout: 30 * time.Second}))
+	assert.Equal(t, 200*time.Minute, nonceStaleAfter(client.WaitOptions{Timeout: 20 * time.Minute}), "should be well above the wait timeout")
+}
@@ -0,0 +1,256 @@
+package sequencer
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	txproto "buf.build/gen/go/astria/protocol-apis/protocolbuffers/go/astria/protocol/transaction/v1"
+	"github.com/astriaorg/astria-cli-go/modules/go-sequencer-client/client"
+	log "github.com/sirupsen/logrus"
+)
+
+// GetDenom returns the denom of the asset with the given hex encoded ID.
+func GetDenom(assetID string, sequencerURL string) (*DenomResponse, error) {
+	log.Debug("Creating CometBFT client with url: ", sequencerURL)
+
+	c, err := client.NewClient(sequencerURL)
+	if err != nil {
+		log.WithError(err).Error("Error creating sequencer client")
+		return &DenomResponse{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	denom, err := c.GetDenom(ctx, assetID)
+	if err != nil {
+		log.WithError(err).Error("Error getting denom")
+		return &DenomResponse{}, err
+	}
+
+	return &DenomResponse{
+		Height:  denom.Height,
+		AssetID: assetID,
+		Denom:   denom.Denom,
+	}, nil
+}
+
+// GetAllowedFeeAssets returns the assets that can be used to pay fees.
+func GetAllowedFeeAssets(sequencerURL string) (*AllowedFeeAssetsResponse, error) {
+	log.Debug("Creating CometBFT client with url: ", sequencerURL)
+
+	c, err := client.NewClient(sequencerURL)
+	if err != nil {
+		log.WithError(err).Error("Error creating sequencer client")
+		return &AllowedFeeAssetsResponse{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	assets, err := c.GetAllowedFeeAssets(ctx)
+	if err != nil {
+		log.WithError(err).Error("Error getting allowed fee assets")
+		return &AllowedFeeAssetsResponse{}, err
+	}
+
+	return &AllowedFeeAssetsResponse{
+		Height:    assets.Height,
+		FeeAssets: assets.FeeAssets,
+	}, nil
+}
+
+// GetTransactionFee returns the fees that would be charged for a transaction
+// containing the given actions.
+func GetTransactionFee(actions []*txproto.Action, sequencerURL string) (*TransactionFeeResponse, error) {
+	log.Debug("Creating CometBFT client with url: ", sequencerURL)
+
+	c, err := client.NewClient(sequencerURL)
+	if err != nil {
+		log.WithError(err).Error("Error creating sequencer client")
+		return &TransactionFeeResponse{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	tx := &txproto.TransactionBody{
+		Params:  &txproto.TransactionParams{},
+		Actions: actions,
+	}
+	fees, err := c.GetTransactionFee(ctx, tx)
+	if err != nil {
+		log.WithError(err).Error("Error getting transaction fee")
+		return &TransactionFeeResponse{}, err
+	}
+
+	res := &TransactionFeeResponse{
+		Height: fees.Height,
+		Fees:   make([]*Balance, len(fees.Fees)),
+	}
+	for i, fee := range fees.Fees {
+		res.Fees[i] = &Balance{
+			Denom:   fee.Asset,
+			Balance: fee.Fee,
+		}
+	}
+	return res, nil
+}
+
+// GetBridgeAccountInfo returns the rollup, asset and privileged addresses of
+// a bridge account.
+func GetBridgeAccountInfo(address string, sequencerURL string) (*BridgeAccountInfoResponse, error) {
+	log.Debug("Creating CometBFT client with url: ", sequencerURL)
+
+	c, err := client.NewClient(sequencerURL)
+	if err != nil {
+		log.WithError(err).Error("Error creating sequencer client")
+		return &BridgeAccountInfoResponse{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	info, err := c.GetBridgeAccountInfo(ctx, address)
+	if err != nil {
+		log.WithError(err).Error("Error getting bridge account info")
+		return &BridgeAccountInfoResponse{}, err
+	}
+
+	return &BridgeAccountInfoResponse{
+		Height:            info.Height,
+		Address:           address,
+		IsBridge:          info.IsBridge,
+		RollupID:          hex.EncodeToString(info.RollupID),
+		Asset:             info.Asset,
+		SudoAddress:       info.SudoAddress,
+		WithdrawerAddress: info.WithdrawerAddress,
+	}, nil
+}
+
+// GetBridgeAccountLastTxHash returns the hash of the last transaction that
+// used a bridge account.
+func GetBridgeAccountLastTxHash(address string, sequencerURL string) (*BridgeAccountLastTxHashResponse, error) {
+	log.Debug("Creating CometBFT client with url: ", sequencerURL)
+
+	c, err := client.NewClient(sequencerURL)
+	if err != nil {
+		log.WithError(err).Error("Error creating sequencer client")
+		return &BridgeAccountLastTxHashResponse{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	last, err := c.GetBridgeAccountLastTxHash(ctx, address)
+	if err != nil {
+		log.WithError(err).Error("Error getting bridge account last transaction hash")
+		return &BridgeAccountLastTxHashResponse{}, err
+	}
+
+	return &BridgeAccountLastTxHashResponse{
+		Height:  last.Height,
+		Address: address,
+		TxHash:  hex.EncodeToString(last.TxHash),
+	}, nil
+}
+
+// GetAppInfo returns the application version and the last committed block
+// reported by the sequencer.
+func GetAppInfo(sequencerURL string) (*AppInfoResponse, error) {
+	log.Debug("Creating CometBFT client with url: ", sequencerURL)
+
+	c, err := client.NewClient(sequencerURL)
+	if err != nil {
+		log.WithError(err).Error("Error creating sequencer client")
+		return &AppInfoResponse{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	info, err := c.GetABCIInfo(ctx)
+	if err != nil {
+		log.WithError(err).Error("Error getting app info")
+		return &AppInfoResponse{}, err
+	}
+
+	return &AppInfoResponse{
+		Data:             info.Data,
+		Version:          info.Version,
+		AppVersion:       info.AppVersion,
+		LastBlockHeight:  info.LastBlockHeight,
+		LastBlockAppHash: hex.EncodeToString(info.LastBlockAppHash),
+	}, nil
+}
+
+// GetBridgeInfo returns the configuration of a bridge account along with the
+// last transaction that used it. If that transaction withdrew from the bridge
+// account, the withdrawal action is included.
+func GetBridgeInfo(opts BridgeInfoOpts) (*BridgeInfoResponse, error) {
+	log.Debug("Creating CometBFT client with url: ", opts.SequencerURL)
+
+	c, err := client.NewClient(opts.SequencerURL)
+	if err != nil {
+		log.WithError(err).Error("Error creating sequencer client")
+		return &BridgeInfoResponse{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	info, err := c.GetBridgeAccountInfo(ctx, opts.BridgeAddress)
+	if err != nil {
+		log.WithError(err).Error("Error getting bridge account info")
+		return &BridgeInfoResponse{}, err
+	}
+	if !info.IsBridge {
+		err := fmt.Errorf("%s is not a bridge account", opts.BridgeAddress)
+		log.WithError(err).Error("Error getting bridge account info")
+		return &BridgeInfoResponse{}, err
+	}
+
+	last, err := c.GetBridgeAccountLastTxHash(ctx, opts.BridgeAddress)
+	if err != nil {
+		log.WithError(err).Error("Error getting bridge account last transaction hash")
+		return &BridgeInfoResponse{}, err
+	}
+
+	res := &BridgeInfoResponse{
+		Address:           opts.BridgeAddress,
+		RollupID:          hex.EncodeToString(info.RollupID),
+		Asset:             info.Asset,
+		SudoAddress:       info.SudoAddress,
+		WithdrawerAddress: info.WithdrawerAddress,
+		LastTxHash:        hex.EncodeToString(last.TxHash),
+	}
+	if len(last.TxHash) == 0 {
+		return res, nil
+	}
+
+	// the transaction may have been pruned or the node may not index
+	// transactions, which should not hide the rest of the bridge info
+	tx, err := c.GetTx(ctx, last.TxHash)
+	if err != nil {
+		log.WithError(err).Warn("Could not retrieve the bridge account's last transaction")
+		return res, nil
+	}
+	res.LastTxHeight = tx.Height
+
+	decoded, err := DecodeTxBytes(tx.Tx, opts.AddressPrefix)
+	if err != nil {
+		log.WithError(err).Warn("Could not decode the bridge account's last transaction")
+		return res, nil
+	}
+	for i := len(decoded.Actions) - 1; i >= 0; i-- {
+		action := decoded.Actions[i]
+		if (action.Type == "bridge_unlock" || action.Type == "ics20_withdrawal") && action.Fields["bridgeAddress"] == opts.BridgeAddress {
+			res.LastWithdrawal = &action
+			break
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,186 @@
+package sequencer
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	primproto "buf.build/gen/go/astria/primitives/protocolbuffers/go/astria/primitive/v1"
+	assetproto "buf.build/gen/go/astria/protocol-apis/protocolbuffers/go/astria/protocol/asset/v1"
+	bridgeproto "buf.build/gen/go/astria/protocol-apis/protocolbuffers/go/astria/protocol/bridge/v1"
+	feesproto "buf.build/gen/go/astria/protocol-apis/protocolbuffers/go/astria/protocol/fees/v1"
+	txproto "buf.build/gen/go/astria/protocol-apis/protocolbuffers/go/astria/protocol/transaction/v1"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// newTestQueryServer serves CometBFT `abci_query` requests for the given
+// paths, `tx` requests for the given transactions, and `abci_info` requests.
+func newTestQueryServer(t *testing.T, responses map[string]proto.Message, txs cmttypes.Txs) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req rpctypes.RPCRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		var result any
+		switch req.Method {
+		case "abci_info":
+			result = &coretypes.ResultABCIInfo{Response: abcitypes.ResponseInfo{
+				Data:             "astria_sequencer",
+				Version:          "1.0.0",
+				AppVersion:       1,
+				LastBlockHeight:  42,
+				LastBlockAppHash: []byte{0xab, 0xcd},
+			}}
+		case "abci_query":
+			var params struct {
+				Path string `json:"path"`
+			}
+			require.NoError(t, json.Unmarshal(req.Params, &params))
+			resp, ok := responses[params.Path]
+			if !ok {
+				result = &coretypes.ResultABCIQuery{Response: abcitypes.ResponseQuery{Code: 1, Log: "unknown path"}}
+				break
+			}
+			value, err := proto.Marshal(resp)
+			require.NoError(t, err)
+			result = &coretypes.ResultABCIQuery{Response: abcitypes.ResponseQuery{Value: value}}
+		case "tx":
+			var params struct {
+				Hash []byte `json:"hash"`
+			}
+			require.NoError(t, json.Unmarshal(req.Params, &params))
+			index := txs.IndexByHash(params.Hash)
+			if index < 0 {
+				require.NoError(t, json.NewEncoder(w).Encode(rpctypes.RPCInternalError(req.ID, errors.New("tx not found"))))
+				return
+			}
+			result = &coretypes.ResultTx{Hash: params.Hash, Height: 9, Tx: txs[index]}
+		default:
+			t.Fatalf("unexpected method %s", req.Method)
+		}
+
+		data, err := cmtjson.Marshal(result)
+		require.NoError(t, err)
+		require.NoError(t, json.NewEncoder(w).Encode(rpctypes.RPCResponse{JSONRPC: "2.0", ID: req.ID, Result: data}))
+	}))
+}
+
+func TestQueries(t *testing.T) {
+	rollupID := rollupIdFromText("test-rollup")
+	server := newTestQueryServer(t, map[string]proto.Message{
+		"asset/denom/abcd": &assetproto.DenomResponse{Height: 5, Denom: "transfer/channel-0/utia"},
+		"asset/allowed_fee_assets": &assetproto.AllowedFeeAssetsResponse{
+			Height:    5,
+			FeeAssets: []string{"ntia", "transfer/channel-0/utia"},
+		},
+		"transaction/fee": &feesproto.TransactionFeeResponse{
+			Height: 5,
+			Fees: []*feesproto.TransactionFee{
+				{Asset: "ntia", Fee: &primproto.Uint128{Lo: 12}},
+			},
+		},
+		"bridge/account_info/" + testAddress: &bridgeproto.BridgeAccountInfoResponse{
+			Height:            5,
+			RollupId:          rollupID,
+			Asset:             proto.String("ntia"),
+			SudoAddress:       &primproto.Address{Bech32M: testAddress},
+			WithdrawerAddress: &primproto.Address{Bech32M: testOtherAddress},
+		},
+		"bridge/account_info/" + testOtherAddress:         &bridgeproto.BridgeAccountInfoResponse{Height: 5},
+		"bridge/account_last_tx_hash/" + testAddress:      &bridgeproto.BridgeAccountLastTxHashResponse{Height: 5, TxHash: []byte{0x01, 0x02}},
+		"bridge/account_last_tx_hash/" + testOtherAddress: &bridgeproto.BridgeAccountLastTxHashResponse{Height: 5},
+	}, nil)
+	defer server.Close()
+
+	denom, err := GetDenom("abcd", server.URL)
+	require.NoError(t, err)
+	assert.Equal(t, "transfer/channel-0/utia", denom.Denom)
+	assert.Equal(t, uint64(5), denom.Height)
+
+	_, err = GetDenom("ffff", server.URL)
+	assert.Error(t, err, "non-zero response codes should be returned as errors")
+
+	assets, err := GetAllowedFeeAssets(server.URL)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ntia", "transfer/channel-0/utia"}, assets.FeeAssets)
+
+	fees, err := GetTransactionFee(testBody(t).Actions, server.URL)
+	require.NoError(t, err)
+	require.Len(t, fees.Fees, 1)
+	assert.Equal(t, "ntia", fees.Fees[0].Denom)
+	assert.Equal(t, "12", fees.Fees[0].Balance.String())
+
+	info, err := GetBridgeAccountInfo(testAddress, server.URL)
+	require.NoError(t, err)
+	assert.True(t, info.IsBridge)
+	assert.Equal(t, rollupIDString(rollupID), info.RollupID)
+	assert.Equal(t, testAddress, info.SudoAddress)
+	assert.Equal(t, testOtherAddress, info.WithdrawerAddress)
+
+	notBridge, err := GetBridgeAccountInfo(testOtherAddress, server.URL)
+	require.NoError(t, err)
+	assert.False(t, notBridge.IsBridge)
+
+	last, err := GetBridgeAccountLastTxHash(testAddress, server.URL)
+	require.NoError(t, err)
+	assert.Equal(t, "0102", last.TxHash)
+
+	unused, err := GetBridgeAccountLastTxHash(testOtherAddress, server.URL)
+	require.NoError(t, err)
+	assert.Empty(t, unused.TxHash)
+
+	app, err := GetAppInfo(server.URL)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), app.AppVersion)
+	assert.Equal(t, int64(42), app.LastBlockHeight)
+	assert.Equal(t, "abcd", app.LastBlockAppHash)
+}
+
+func TestGetBridgeInfo(t *testing.T) {
+	_, unlock := signTestActions(t, []*txproto.Action{
+		{Value: &txproto.Action_BridgeUnlock{BridgeUnlock: &txproto.BridgeUnlock{
+			To:                      &primproto.Address{Bech32M: testAddress},
+			Amount:                  &primproto.Uint128{Lo: 100},
+			FeeAsset:                "ntia",
+			BridgeAddress:           &primproto.Address{Bech32M: testOtherAddress},
+			RollupBlockNumber:       17,
+			RollupWithdrawalEventId: "0xevent",
+		}}},
+	})
+	bridgeInfo := &bridgeproto.BridgeAccountInfoResponse{
+		Height:            5,
+		RollupId:          rollupIdFromText("test-rollup"),
+		Asset:             proto.String("ntia"),
+		SudoAddress:       &primproto.Address{Bech32M: testAddress},
+		WithdrawerAddress: &primproto.Address{Bech32M: testAddress},
+	}
+	server := newTestQueryServer(t, map[string]proto.Message{
+		"bridge/account_info/" + testOtherAddress:         bridgeInfo,
+		"bridge/account_last_tx_hash/" + testOtherAddress: &bridgeproto.BridgeAccountLastTxHashResponse{Height: 5, TxHash: cmttypes.Tx(unlock).Hash()},
+		"bridge/account_info/" + testAddress:              &bridgeproto.BridgeAccountInfoResponse{Height: 5},
+	}, cmttypes.Txs{unlock})
+	defer server.Close()
+
+	info, err := GetBridgeInfo(BridgeInfoOpts{AddressPrefix: "astria", SequencerURL: server.URL, BridgeAddress: testOtherAddress})
+	require.NoError(t, err)
+	assert.Equal(t, rollupIDString(rollupIdFromText("test-rollup")), info.RollupID)
+	assert.Equal(t, "ntia", info.Asset)
+	assert.Equal(t, hex.EncodeToString(cmttypes.Tx(unlock).Hash()), info.LastTxHash)
+	assert.Equal(t, int64(9), info.LastTxHeight)
+	require.NotNil(t, info.LastWithdrawal)
+	assert.Equal(t, "bridge_unlock", info.LastWithdrawal.Type)
+	assert.Equal(t, "17", info.LastWithdrawal.Fields["rollupBlockNumber"])
+	assert.Equal(t, "0xevent", info.LastWithdrawal.Fields["rollupWithdrawalEventId"])
+
+	_, err = GetBridgeInfo(BridgeInfoOpts{AddressPrefix: "astria", SequencerURL: server.URL, BridgeAddress: testAddress})
+	assert.Error(t, err, "non-bridge accounts should be rejected")
+}
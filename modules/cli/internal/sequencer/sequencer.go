@@ -6,9 +6,13 @@ import (
 	"encoding/hex"
 	"fmt"
 	"math"
+	"strconv"
+	"strings"
 	"time"
 
 	txproto "buf.build/gen/go/astria/protocol-apis/protocolbuffers/go/astria/protocol/transaction/v1"
+	abci "buf.build/gen/go/astria/protocol-apis/protocolbuffers/go/astria_vendored/tendermint/abci"
+	crypto "buf.build/gen/go/astria/protocol-apis/protocolbuffers/go/astria_vendored/tendermint/crypto"
 	"github.com/astriaorg/astria-cli-go/modules/bech32m"
 	"github.com/astriaorg/astria-cli-go/modules/go-sequencer-client/client"
 	log "github.com/sirupsen/logrus"
@@ -78,7 +82,8 @@ func GetBalances(address string, sequencerURL string) (*BalancesResponse, error)
 	return &b, nil
 }
 
-// GetBlock returns the specific block from the sequencer.
+// GetBlock returns the specific block from the sequencer with its
+// transactions decoded. Only transactions matching opts.Filter are returned.
 func GetBlock(opts BlockOpts) (*BlockResponse, error) {
 	log.Debug("Creating CometBFT client with url: ", opts.SequencerURL)
 
@@ -99,7 +104,12 @@ func GetBlock(opts BlockOpts) (*BlockResponse, error) {
 
 	log.Debug("Retrieved Block at block height: ", opts.BlockHeight)
 	return &BlockResponse{
-		Block: block,
+		Block:    block,
+		Height:   block.Block.Height,
+		Hash:     hex.EncodeToString(block.BlockID.Hash),
+		Time:     block.Block.Time.UTC().Format(time.RFC3339Nano),
+		Proposer: hex.EncodeToString(block.Block.ProposerAddress),
+		Txs:      decodeBlockTxs(block.Block.Txs, opts.AddressPrefix, opts.Filter),
 	}, nil
 }
 
@@ -155,255 +165,690 @@ func GetNonce(address string, sequencerURL string) (*NonceResponse, error) {
 	}, nil
 }
 
+// TransferAction returns the transfer action described by opts.
+func TransferAction(opts TransferOpts) *txproto.Action {
+	return &txproto.Action{
+		Value: &txproto.Action_Transfer{
+			Transfer: &txproto.Transfer{
+				To:       opts.ToAddress,
+				Amount:   opts.Amount,
+				Asset:    opts.Asset,
+				FeeAsset: opts.FeeAsset,
+			},
+		},
+	}
+}
+
 // Transfer transfers an amount from one address to another.
 // It returns the hash of the transaction.
 func Transfer(opts TransferOpts) (*TransferResponse, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), broadcastTimeout(opts.Wait))
 	defer cancel()
 
-	// client
-	log.Debug("Creating CometBFT client with url: ", opts.SequencerURL)
-	c, err := client.NewClient(opts.SequencerURL)
+	res, err := signAndBroadcast(ctx, signerOpts{
+		SequencerURL:     opts.SequencerURL,
+		SequencerChainID: opts.SequencerChainID,
+		AddressPrefix:    opts.AddressPrefix,
+		FromKey:          opts.FromKey,
+		Wait:             opts.Wait,
+		UseNonceManager:  opts.UseNonceManager,
+	}, TransferAction(opts))
 	if err != nil {
-		log.WithError(err).Error("Error creating sequencer client")
 		return &TransferResponse{}, err
 	}
 
-	signer := client.NewSigner(opts.FromKey)
-	fromAddr := signer.Address()
-	addr, err := bech32m.EncodeFromBytes(opts.AddressPrefix, fromAddr)
-	if err != nil {
-		log.WithError(err).Error("Failed to encode address")
-		return nil, err
+	log.Debugf("Transfer hash: %v", res.TxHash)
+	return &TransferResponse{
+		From:   res.From,
+		To:     opts.ToAddress.Bech32M,
+		Nonce:  res.Nonce,
+		Amount: fmt.Sprint(client.ProtoU128ToBigInt(opts.Amount)),
+		TxHash: res.TxHash,
+	}, nil
+}
+
+// IbcTransferAction returns the ICS20 withdrawal action described by opts.
+func IbcTransferAction(opts IbcTransferOpts) *txproto.Action {
+	return &txproto.Action{
+		Value: &txproto.Action_Ics20Withdrawal{
+			Ics20Withdrawal: &txproto.Ics20Withdrawal{
+				Amount:                  opts.Amount,
+				Denom:                   opts.Asset,
+				DestinationChainAddress: opts.DestinationChainAddressAddress,
+				ReturnAddress:           opts.ReturnAddress,
+				TimeoutHeight: &txproto.IbcHeight{
+					RevisionNumber: math.MaxUint64,
+					RevisionHeight: math.MaxUint64,
+				},
+				TimeoutTime:   nowPlusFiveMinutes(),
+				SourceChannel: opts.SourceChannelID,
+				FeeAsset:      opts.FeeAsset,
+			},
+		},
 	}
-	nonce, err := c.GetNonce(ctx, addr.String())
+}
+
+// IbcTransfer performs an ICS20 withdrawal from the sequencer to a recipient on another chain.
+func IbcTransfer(opts IbcTransferOpts) (*IbcTransferResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), broadcastTimeout(opts.Wait))
+	defer cancel()
+
+	res, err := signAndBroadcast(ctx, signerOpts{
+		SequencerURL:     opts.SequencerURL,
+		SequencerChainID: opts.SequencerChainID,
+		AddressPrefix:    opts.AddressPrefix,
+		FromKey:          opts.FromKey,
+		Wait:             opts.Wait,
+		UseNonceManager:  opts.UseNonceManager,
+	}, IbcTransferAction(opts))
 	if err != nil {
-		log.WithError(err).Error("Error getting nonce")
-		return &TransferResponse{}, err
+		return &IbcTransferResponse{}, err
 	}
-	log.Debugf("Nonce: %v", nonce)
 
-	tx := &txproto.TransactionBody{
-		Params: &txproto.TransactionParams{
-			ChainId: opts.SequencerChainID,
-			Nonce:   nonce,
-		},
-		Actions: []*txproto.Action{
-			{
-				Value: &txproto.Action_Transfer{
-					Transfer: &txproto.Transfer{
-						To:       opts.ToAddress,
-						Amount:   opts.Amount,
-						Asset:    opts.Asset,
-						FeeAsset: opts.FeeAsset,
-					},
-				},
+	log.Debugf("Transfer hash: %v", res.TxHash)
+	return &IbcTransferResponse{
+		From:   res.From,
+		To:     opts.DestinationChainAddressAddress,
+		Nonce:  res.Nonce,
+		Amount: fmt.Sprint(client.ProtoU128ToBigInt(opts.Amount)),
+		TxHash: res.TxHash,
+	}, nil
+}
+
+// InitBridgeAccountAction returns the init bridge account action described by
+// opts.
+func InitBridgeAccountAction(opts InitBridgeOpts) *txproto.Action {
+	return &txproto.Action{
+		Value: &txproto.Action_InitBridgeAccount{
+			InitBridgeAccount: &txproto.InitBridgeAccount{
+				RollupId:          rollupIdFromText(opts.RollupName),
+				Asset:             opts.Asset,
+				FeeAsset:          opts.FeeAsset,
+				SudoAddress:       opts.SudoAddress,
+				WithdrawerAddress: opts.WithdrawerAddress,
 			},
 		},
 	}
+}
 
-	// sign transaction
-	signed, err := signer.SignTransaction(tx)
+func InitBridgeAccount(opts InitBridgeOpts) (*InitBridgeResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), broadcastTimeout(opts.Wait))
+	defer cancel()
+
+	res, err := signAndBroadcast(ctx, signerOpts{
+		SequencerURL:     opts.SequencerURL,
+		SequencerChainID: opts.SequencerChainID,
+		AddressPrefix:    opts.AddressPrefix,
+		FromKey:          opts.FromKey,
+		Wait:             opts.Wait,
+		UseNonceManager:  opts.UseNonceManager,
+	}, InitBridgeAccountAction(opts))
 	if err != nil {
-		log.WithError(err).Error("Error signing transaction")
-		return &TransferResponse{}, err
+		return &InitBridgeResponse{}, err
 	}
 
-	// broadcast tx
-	resp, err := c.BroadcastTx(ctx, signed, opts.IsAsync)
+	log.Debugf("Transfer hash: %v", res.TxHash)
+	return &InitBridgeResponse{
+		RollupID: opts.RollupName,
+		Nonce:    res.Nonce,
+		TxHash:   res.TxHash,
+	}, nil
+}
+
+// BridgeLockAction returns the bridge lock action described by opts.
+func BridgeLockAction(opts BridgeLockOpts) *txproto.Action {
+	return &txproto.Action{
+		Value: &txproto.Action_BridgeLock{
+			BridgeLock: &txproto.BridgeLock{
+				To:                      opts.ToAddress,
+				Amount:                  opts.Amount,
+				Asset:                   opts.Asset,
+				FeeAsset:                opts.FeeAsset,
+				DestinationChainAddress: opts.DestinationChainAddress,
+			},
+		},
+	}
+}
+
+// BridgeLock locks tokens on the source chain and initiates a cross-chain transfer to the destination chain.
+func BridgeLock(opts BridgeLockOpts) (*BridgeLockResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), broadcastTimeout(opts.Wait))
+	defer cancel()
+
+	// opts holds the sender's private key, so only log what is locked
+	log.Debugf("Locking %v to bridge account %s", client.ProtoU128ToBigInt(opts.Amount), opts.ToAddress.GetBech32M())
+
+	res, err := signAndBroadcast(ctx, signerOpts{
+		SequencerURL:     opts.SequencerURL,
+		SequencerChainID: opts.SequencerChainID,
+		AddressPrefix:    opts.AddressPrefix,
+		FromKey:          opts.FromKey,
+		Wait:             opts.Wait,
+		UseNonceManager:  opts.UseNonceManager,
+	}, BridgeLockAction(opts))
 	if err != nil {
-		log.WithError(err).Error("Error broadcasting transaction")
-		return &TransferResponse{}, err
+		return &BridgeLockResponse{}, err
 	}
-	log.Debugf("Broadcast response: %v", resp)
-	// response
-	hash := hex.EncodeToString(resp.Hash)
-	amount := fmt.Sprint(client.ProtoU128ToBigInt(opts.Amount))
-	tr := &TransferResponse{
-		From:   addr.String(),
+
+	log.Debugf("Transfer hash: %v", res.TxHash)
+	return &BridgeLockResponse{
+		From:   res.From,
 		To:     opts.ToAddress.Bech32M,
-		Nonce:  nonce,
-		Amount: amount,
-		TxHash: hash,
-	}
+		Nonce:  res.Nonce,
+		Amount: opts.Amount.String(),
+		TxHash: res.TxHash,
+	}, nil
+}
 
-	log.Debugf("Transfer hash: %v", hash)
-	return tr, nil
+// signerOpts are the options shared by the functions that sign a transaction
+// with a private key and broadcast it.
+type signerOpts struct {
+	// SequencerURL is the URL of the sequencer
+	SequencerURL string
+	// SequencerChainID is the chain ID of the sequencer
+	SequencerChainID string
+	// AddressPrefix is the prefix of the signer's address
+	AddressPrefix string
+	// FromKey is the private key of the signer
+	FromKey ed25519.PrivateKey
+	// Wait configures how long to wait for the transaction after it is
+	// broadcast.
+	Wait client.WaitOptions
+	// UseNonceManager reserves the transaction's nonce with the nonce manager
+	// instead of using the sequencer's nonce.
+	UseNonceManager bool
 }
 
-// IbcTransfer performs an ICS20 withdrawal from the sequencer to a recipient on another chain.
-func IbcTransfer(opts IbcTransferOpts) (*IbcTransferResponse, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
+// broadcastResult is the outcome of a transaction broadcast by
+// signAndBroadcast.
+type broadcastResult struct {
+	// From is the address of the signer
+	From string
+	// Nonce is the nonce of the transaction
+	Nonce uint32
+	// TxHash is the hex encoded hash of the transaction
+	TxHash string
+}
 
+// signAndBroadcast signs a transaction containing actions with the signer's
+// next nonce and broadcasts it to the sequencer.
+func signAndBroadcast(ctx context.Context, opts signerOpts, actions ...*txproto.Action) (*broadcastResult, error) {
 	// client
 	log.Debug("Creating CometBFT client with url: ", opts.SequencerURL)
 	c, err := client.NewClient(opts.SequencerURL)
 	if err != nil {
 		log.WithError(err).Error("Error creating sequencer client")
-		return &IbcTransferResponse{}, err
+		return nil, err
 	}
 
+	// Get current address nonce
 	signer := client.NewSigner(opts.FromKey)
-	fromAddr := signer.Address()
-	addr, err := bech32m.EncodeFromBytes(opts.AddressPrefix, fromAddr)
+	addr, err := bech32m.EncodeFromBytes(opts.AddressPrefix, signer.Address())
 	if err != nil {
 		log.WithError(err).Error("Failed to encode address")
 		return nil, err
 	}
-	nonce, err := c.GetNonce(ctx, addr.String())
+	reservation, err := reserveNonce(ctx, c, opts.SequencerChainID, addr.String(), 1, opts.Wait, opts.UseNonceManager)
 	if err != nil {
-		log.WithError(err).Error("Error getting nonce")
-		return &IbcTransferResponse{}, err
+		log.WithError(err).Error("Error reserving nonce")
+		return nil, err
 	}
-	log.Debugf("Nonce: %v", nonce)
+	nonce := reservation.Nonce
+	// the nonce is released if the transaction is never broadcast, so that
+	// later transactions do not wait on it
+	broadcast := false
+	defer func() {
+		if broadcast {
+			return
+		}
+		if err := reservation.Release(nonce); err != nil {
+			log.WithError(err).Warn("Error releasing nonce")
+		}
+	}()
 
 	tx := &txproto.TransactionBody{
 		Params: &txproto.TransactionParams{
 			ChainId: opts.SequencerChainID,
 			Nonce:   nonce,
 		},
-		Actions: []*txproto.Action{
-			{
-				Value: &txproto.Action_Ics20Withdrawal{
-					Ics20Withdrawal: &txproto.Ics20Withdrawal{
-						Amount:                  opts.Amount,
-						Denom:                   opts.Asset,
-						DestinationChainAddress: opts.DestinationChainAddressAddress,
-						ReturnAddress:           opts.ReturnAddress,
-						TimeoutHeight: &txproto.IbcHeight{
-							RevisionNumber: math.MaxUint64,
-							RevisionHeight: math.MaxUint64,
-						},
-						TimeoutTime:   nowPlusFiveMinutes(),
-						SourceChannel: opts.SourceChannelID,
-						FeeAsset:      opts.FeeAsset,
-					},
-				},
-			},
-		},
+		Actions: actions,
 	}
 
 	// sign transaction
 	signed, err := signer.SignTransaction(tx)
 	if err != nil {
 		log.WithError(err).Error("Error signing transaction")
-		return &IbcTransferResponse{}, err
+		return nil, err
 	}
 
 	// broadcast tx
-	resp, err := c.BroadcastTx(ctx, signed, opts.IsAsync)
+	resp, err := c.BroadcastTx(ctx, signed, opts.Wait)
+	broadcast = true
+	reservation.Done(ctx, resp, err)
 	if err != nil {
 		log.WithError(err).Error("Error broadcasting transaction")
-		return &IbcTransferResponse{}, err
+		return nil, err
 	}
 	log.Debugf("Broadcast response: %v", resp)
-	// response
-	hash := hex.EncodeToString(resp.Hash)
-	amount := fmt.Sprint(client.ProtoU128ToBigInt(opts.Amount))
-	tr := &IbcTransferResponse{
+
+	return &broadcastResult{
 		From:   addr.String(),
-		To:     opts.DestinationChainAddressAddress,
 		Nonce:  nonce,
-		Amount: amount,
-		TxHash: hash,
+		TxHash: hex.EncodeToString(resp.Hash),
+	}, nil
+}
+
+// AddFeeAsset adds a fee asset to the list of assets allowed to pay
+// transaction fees on the sequencer. The signer must be the sudo address.
+func AddFeeAsset(opts FeeAssetOpts) (*FeeAssetResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), broadcastTimeout(opts.Wait))
+	defer cancel()
+
+	res, err := signAndBroadcast(ctx, signerOpts{
+		SequencerURL:     opts.SequencerURL,
+		SequencerChainID: opts.SequencerChainID,
+		AddressPrefix:    opts.AddressPrefix,
+		FromKey:          opts.FromKey,
+		Wait:             opts.Wait,
+		UseNonceManager:  opts.UseNonceManager,
+	}, &txproto.Action{
+		Value: &txproto.Action_FeeAssetChange{
+			FeeAssetChange: &txproto.FeeAssetChange{
+				Value: &txproto.FeeAssetChange_Addition{
+					Addition: opts.Asset,
+				},
+			},
+		},
+	})
+	if err != nil {
+		return &FeeAssetResponse{}, err
 	}
 
-	log.Debugf("Transfer hash: %v", hash)
-	return tr, nil
+	log.Debugf("Add fee asset hash: %v", res.TxHash)
+	return &FeeAssetResponse{
+		From:       res.From,
+		Nonce:      res.Nonce,
+		TxHash:     res.TxHash,
+		FeeAssetId: opts.Asset,
+	}, nil
 }
 
-func InitBridgeAccount(opts InitBridgeOpts) (*InitBridgeResponse, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+// RemoveFeeAsset removes a fee asset from the list of assets allowed to pay
+// transaction fees on the sequencer. The signer must be the sudo address.
+func RemoveFeeAsset(opts FeeAssetOpts) (*FeeAssetResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), broadcastTimeout(opts.Wait))
 	defer cancel()
 
-	// client
-	log.Debug("Creating CometBFT client with url: ", opts.SequencerURL)
-	c, err := client.NewClient(opts.SequencerURL)
+	res, err := signAndBroadcast(ctx, signerOpts{
+		SequencerURL:     opts.SequencerURL,
+		SequencerChainID: opts.SequencerChainID,
+		AddressPrefix:    opts.AddressPrefix,
+		FromKey:          opts.FromKey,
+		Wait:             opts.Wait,
+		UseNonceManager:  opts.UseNonceManager,
+	}, &txproto.Action{
+		Value: &txproto.Action_FeeAssetChange{
+			FeeAssetChange: &txproto.FeeAssetChange{
+				Value: &txproto.FeeAssetChange_Removal{
+					Removal: opts.Asset,
+				},
+			},
+		},
+	})
 	if err != nil {
-		log.WithError(err).Error("Error creating sequencer client")
-		return &InitBridgeResponse{}, err
+		return &FeeAssetResponse{}, err
 	}
 
-	// Get current address nonce
-	signer := client.NewSigner(opts.FromKey)
-	fromAddr := signer.Address()
-	addr, err := bech32m.EncodeFromBytes(opts.AddressPrefix, fromAddr)
+	log.Debugf("Remove fee asset hash: %v", res.TxHash)
+	return &FeeAssetResponse{
+		From:       res.From,
+		Nonce:      res.Nonce,
+		TxHash:     res.TxHash,
+		FeeAssetId: opts.Asset,
+	}, nil
+}
+
+// AddIBCRelayer adds an address to the list of addresses allowed to relay IBC
+// messages to the sequencer. The signer must be the IBC sudo address.
+func AddIBCRelayer(opts IBCRelayerOpts) (*IBCRelayerResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), broadcastTimeout(opts.Wait))
+	defer cancel()
+
+	res, err := signAndBroadcast(ctx, signerOpts{
+		SequencerURL:     opts.SequencerURL,
+		SequencerChainID: opts.SequencerChainID,
+		AddressPrefix:    opts.AddressPrefix,
+		FromKey:          opts.FromKey,
+		Wait:             opts.Wait,
+		UseNonceManager:  opts.UseNonceManager,
+	}, &txproto.Action{
+		Value: &txproto.Action_IbcRelayerChange{
+			IbcRelayerChange: &txproto.IbcRelayerChange{
+				Value: &txproto.IbcRelayerChange_Addition{
+					Addition: opts.IBCRelayerAddress,
+				},
+			},
+		},
+	})
 	if err != nil {
-		log.WithError(err).Error("Failed to encode address")
-		return nil, err
+		return &IBCRelayerResponse{}, err
 	}
-	nonce, err := c.GetNonce(ctx, addr.String())
+
+	log.Debugf("Add IBC relayer hash: %v", res.TxHash)
+	return &IBCRelayerResponse{
+		From:              res.From,
+		Nonce:             res.Nonce,
+		TxHash:            res.TxHash,
+		IBCRelayerAddress: opts.IBCRelayerAddress.Bech32M,
+	}, nil
+}
+
+// RemoveIBCRelayer removes an address from the list of addresses allowed to
+// relay IBC messages to the sequencer. The signer must be the IBC sudo address.
+func RemoveIBCRelayer(opts IBCRelayerOpts) (*IBCRelayerResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), broadcastTimeout(opts.Wait))
+	defer cancel()
+
+	res, err := signAndBroadcast(ctx, signerOpts{
+		SequencerURL:     opts.SequencerURL,
+		SequencerChainID: opts.SequencerChainID,
+		AddressPrefix:    opts.AddressPrefix,
+		FromKey:          opts.FromKey,
+		Wait:             opts.Wait,
+		UseNonceManager:  opts.UseNonceManager,
+	}, &txproto.Action{
+		Value: &txproto.Action_IbcRelayerChange{
+			IbcRelayerChange: &txproto.IbcRelayerChange{
+				Value: &txproto.IbcRelayerChange_Removal{
+					Removal: opts.IBCRelayerAddress,
+				},
+			},
+		},
+	})
 	if err != nil {
-		log.WithError(err).Error("Error getting nonce")
-		return &InitBridgeResponse{}, err
+		return &IBCRelayerResponse{}, err
 	}
 
-	// build transaction
-	tx := &txproto.TransactionBody{
-		Params: &txproto.TransactionParams{
-			ChainId: opts.SequencerChainID,
-			Nonce:   nonce,
+	log.Debugf("Remove IBC relayer hash: %v", res.TxHash)
+	return &IBCRelayerResponse{
+		From:              res.From,
+		Nonce:             res.Nonce,
+		TxHash:            res.TxHash,
+		IBCRelayerAddress: opts.IBCRelayerAddress.Bech32M,
+	}, nil
+}
+
+// ChangeSudoAddress changes the sudo address of the sequencer. The signer must
+// be the current sudo address.
+func ChangeSudoAddress(opts ChangeSudoAddressOpts) (*ChangeSudoAddressResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), broadcastTimeout(opts.Wait))
+	defer cancel()
+
+	res, err := signAndBroadcast(ctx, signerOpts{
+		SequencerURL:     opts.SequencerURL,
+		SequencerChainID: opts.SequencerChainID,
+		AddressPrefix:    opts.AddressPrefix,
+		FromKey:          opts.FromKey,
+		Wait:             opts.Wait,
+		UseNonceManager:  opts.UseNonceManager,
+	}, &txproto.Action{
+		Value: &txproto.Action_SudoAddressChange{
+			SudoAddressChange: &txproto.SudoAddressChange{
+				NewAddress: opts.UpdateAddress,
+			},
 		},
-		Actions: []*txproto.Action{
-			{
-				Value: &txproto.Action_InitBridgeAccount{
-					InitBridgeAccount: &txproto.InitBridgeAccount{
-						RollupId:          rollupIdFromText(opts.RollupName),
-						Asset:             opts.Asset,
-						FeeAsset:          opts.FeeAsset,
-						SudoAddress:       opts.SudoAddress,
-						WithdrawerAddress: opts.WithdrawerAddress,
+	})
+	if err != nil {
+		return &ChangeSudoAddressResponse{}, err
+	}
+
+	log.Debugf("Change sudo address hash: %v", res.TxHash)
+	return &ChangeSudoAddressResponse{
+		From:           res.From,
+		Nonce:          res.Nonce,
+		NewSudoAddress: opts.UpdateAddress.Bech32M,
+		TxHash:         res.TxHash,
+	}, nil
+}
+
+// UpdateValidator sets the voting power of a validator on the sequencer. A
+// power of zero removes the validator from the validator set. The signer must
+// be the sudo address.
+func UpdateValidator(opts UpdateValidatorOpts) (*UpdateValidatorResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), broadcastTimeout(opts.Wait))
+	defer cancel()
+
+	res, err := signAndBroadcast(ctx, signerOpts{
+		SequencerURL:     opts.SequencerURL,
+		SequencerChainID: opts.SequencerChainID,
+		AddressPrefix:    opts.AddressPrefix,
+		FromKey:          opts.FromKey,
+		Wait:             opts.Wait,
+		UseNonceManager:  opts.UseNonceManager,
+	}, &txproto.Action{
+		Value: &txproto.Action_ValidatorUpdate{
+			ValidatorUpdate: &abci.ValidatorUpdate{
+				PubKey: &crypto.PublicKey{
+					Sum: &crypto.PublicKey_Ed25519{
+						Ed25519: opts.PubKey,
 					},
 				},
+				Power: opts.Power,
 			},
 		},
+	})
+	if err != nil {
+		return &UpdateValidatorResponse{}, err
 	}
 
-	// sign transaction
-	signed, err := signer.SignTransaction(tx)
+	log.Debugf("Update validator hash: %v", res.TxHash)
+	return &UpdateValidatorResponse{
+		From:   res.From,
+		Nonce:  res.Nonce,
+		PubKey: hex.EncodeToString(opts.PubKey),
+		Power:  strconv.FormatInt(opts.Power, 10),
+		TxHash: res.TxHash,
+	}, nil
+}
+
+// BridgeUnlock withdraws funds from a bridge account to a sequencer address.
+// The signer must be the bridge account's withdrawer address.
+func BridgeUnlock(opts BridgeUnlockOpts) (*BridgeUnlockResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), broadcastTimeout(opts.Wait))
+	defer cancel()
+
+	// opts holds the withdrawer's private key, so only log what is unlocked
+	log.Debugf("Unlocking %v from bridge account %s to %s", client.ProtoU128ToBigInt(opts.Amount), opts.BridgeAddress.GetBech32M(), opts.ToAddress.GetBech32M())
+
+	res, err := signAndBroadcast(ctx, signerOpts{
+		SequencerURL:     opts.SequencerURL,
+		SequencerChainID: opts.SequencerChainID,
+		AddressPrefix:    opts.AddressPrefix,
+		FromKey:          opts.FromKey,
+		Wait:             opts.Wait,
+		UseNonceManager:  opts.UseNonceManager,
+	}, &txproto.Action{
+		Value: &txproto.Action_BridgeUnlock{
+			BridgeUnlock: &txproto.BridgeUnlock{
+				To:                      opts.ToAddress,
+				Amount:                  opts.Amount,
+				FeeAsset:                opts.FeeAsset,
+				Memo:                    opts.Memo,
+				BridgeAddress:           opts.BridgeAddress,
+				RollupBlockNumber:       opts.RollupBlockNumber,
+				RollupWithdrawalEventId: opts.RollupWithdrawalEventId,
+			},
+		},
+	})
 	if err != nil {
-		log.WithError(err).Error("Error signing transaction")
-		return &InitBridgeResponse{}, err
+		return &BridgeUnlockResponse{}, err
 	}
 
-	// broadcast transaction
-	resp, err := c.BroadcastTx(ctx, signed, opts.IsAsync)
+	log.Debugf("Bridge unlock hash: %v", res.TxHash)
+	return &BridgeUnlockResponse{
+		From:          res.From,
+		BridgeAddress: opts.BridgeAddress.Bech32M,
+		To:            opts.ToAddress.Bech32M,
+		Amount:        fmt.Sprint(client.ProtoU128ToBigInt(opts.Amount)),
+		Nonce:         res.Nonce,
+		TxHash:        res.TxHash,
+	}, nil
+}
+
+// BridgeSudoChange changes the sudo and/or withdrawer address of a bridge
+// account. The signer must be the bridge account's current sudo address.
+func BridgeSudoChange(opts BridgeSudoChangeOpts) (*BridgeSudoChangeResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), broadcastTimeout(opts.Wait))
+	defer cancel()
+
+	// opts holds the sudo address's private key, so only log the changes
+	log.Debugf("Changing bridge account %s: sudo address %q, withdrawer address %q", opts.BridgeAddress.GetBech32M(), opts.NewSudoAddress.GetBech32M(), opts.NewWithdrawerAddress.GetBech32M())
+
+	res, err := signAndBroadcast(ctx, signerOpts{
+		SequencerURL:     opts.SequencerURL,
+		SequencerChainID: opts.SequencerChainID,
+		AddressPrefix:    opts.AddressPrefix,
+		FromKey:          opts.FromKey,
+		Wait:             opts.Wait,
+		UseNonceManager:  opts.UseNonceManager,
+	}, &txproto.Action{
+		Value: &txproto.Action_BridgeSudoChange{
+			BridgeSudoChange: &txproto.BridgeSudoChange{
+				BridgeAddress:        opts.BridgeAddress,
+				NewSudoAddress:       opts.NewSudoAddress,
+				NewWithdrawerAddress: opts.NewWithdrawerAddress,
+				FeeAsset:             opts.FeeAsset,
+			},
+		},
+	})
 	if err != nil {
-		log.WithError(err).Error("Error broadcasting transaction")
-		return &InitBridgeResponse{}, err
+		return &BridgeSudoChangeResponse{}, err
 	}
-	log.Debugf("Broadcast response: %v", resp)
 
-	// response
-	hash := hex.EncodeToString(resp.Hash)
-	tr := &InitBridgeResponse{
-		RollupID: opts.RollupName,
-		Nonce:    nonce,
-		TxHash:   hash,
-	}
+	log.Debugf("Bridge sudo change hash: %v", res.TxHash)
+	return &BridgeSudoChangeResponse{
+		From:                 res.From,
+		BridgeAddress:        opts.BridgeAddress.GetBech32M(),
+		NewSudoAddress:       opts.NewSudoAddress.GetBech32M(),
+		NewWithdrawerAddress: opts.NewWithdrawerAddress.GetBech32M(),
+		Nonce:                res.Nonce,
+		TxHash:               res.TxHash,
+	}, nil
+}
 
-	log.Debugf("Transfer hash: %v", hash)
-	return tr, nil
+// SubmitRollupData submits raw data to a rollup on the sequencer. The rollup
+// ID is derived from the rollup name.
+func SubmitRollupData(opts SubmitRollupDataOpts) (*SubmitRollupDataResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), broadcastTimeout(opts.Wait))
+	defer cancel()
+
+	rollupID := rollupIdFromText(opts.RollupName)
+
+	res, err := signAndBroadcast(ctx, signerOpts{
+		SequencerURL:     opts.SequencerURL,
+		SequencerChainID: opts.SequencerChainID,
+		AddressPrefix:    opts.AddressPrefix,
+		FromKey:          opts.FromKey,
+		Wait:             opts.Wait,
+		UseNonceManager:  opts.UseNonceManager,
+	}, &txproto.Action{
+		Value: &txproto.Action_RollupDataSubmission{
+			RollupDataSubmission: &txproto.RollupDataSubmission{
+				RollupId: rollupID,
+				Data:     opts.Data,
+				FeeAsset: opts.FeeAsset,
+			},
+		},
+	})
+	if err != nil {
+		return &SubmitRollupDataResponse{}, err
+	}
 
+	log.Debugf("Submit rollup data hash: %v", res.TxHash)
+	return &SubmitRollupDataResponse{
+		From:       res.From,
+		RollupName: opts.RollupName,
+		RollupID:   hex.EncodeToString(rollupID.Inner),
+		DataSize:   len(opts.Data),
+		Nonce:      res.Nonce,
+		TxHash:     res.TxHash,
+	}, nil
 }
 
-// BridgeLock locks tokens on the source chain and initiates a cross-chain transfer to the destination chain.
-func BridgeLock(opts BridgeLockOpts) (*BridgeLockResponse, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+// BuildAndSendTx builds a single transaction containing all the given
+// actions, then signs and broadcasts it. The actions are executed in order and
+// atomically; if one action fails, the whole transaction fails.
+func BuildAndSendTx(opts BuildTxOpts) (*BuildTxResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), broadcastTimeout(opts.Wait))
 	defer cancel()
 
-	log.Debugf("BridgeLockOpts: %v", opts)
-
-	// client
-	log.Debug("Creating CometBFT client with url: ", opts.SequencerURL)
-	c, err := client.NewClient(opts.SequencerURL)
+	res, err := signAndBroadcast(ctx, signerOpts{
+		SequencerURL:     opts.SequencerURL,
+		SequencerChainID: opts.SequencerChainID,
+		AddressPrefix:    opts.AddressPrefix,
+		FromKey:          opts.FromKey,
+		Wait:             opts.Wait,
+		UseNonceManager:  opts.UseNonceManager,
+	}, opts.Actions...)
 	if err != nil {
-		log.WithError(err).Error("Error creating sequencer client")
-		return &BridgeLockResponse{}, err
+		return &BuildTxResponse{}, err
+	}
+
+	actions := make([]string, len(opts.Actions))
+	for i, action := range opts.Actions {
+		actions[i] = actionName(action)
+	}
+
+	log.Debugf("Transaction hash: %v", res.TxHash)
+	return &BuildTxResponse{
+		From:    res.From,
+		Nonce:   res.Nonce,
+		Actions: actions,
+		TxHash:  res.TxHash,
+	}, nil
+}
+
+// CreateTx builds an unsigned transaction and writes it to a file without
+// contacting the sequencer. The chain ID and nonce must be provided, since
+// they cannot be fetched offline.
+func CreateTx(opts CreateTxOpts) (*CreateTxResponse, error) {
+	tx := &txproto.TransactionBody{
+		Params: &txproto.TransactionParams{
+			ChainId: opts.SequencerChainID,
+			Nonce:   opts.Nonce,
+		},
+		Actions: opts.Actions,
+	}
+
+	if err := WriteProtoFile(opts.OutputPath, tx); err != nil {
+		log.WithError(err).Error("Error writing unsigned transaction")
+		return &CreateTxResponse{}, err
+	}
+	log.Debugf("Unsigned transaction written to: %s", opts.OutputPath)
+
+	actions := make([]string, len(tx.Actions))
+	for i, action := range tx.Actions {
+		actions[i] = actionName(action)
+	}
+	return &CreateTxResponse{
+		Path:     opts.OutputPath,
+		JSONPath: JSONRenderingPath(opts.OutputPath),
+		ChainID:  opts.SequencerChainID,
+		Nonce:    opts.Nonce,
+		Actions:  actions,
+	}, nil
+}
+
+// SignTx reads an unsigned transaction from a file, signs it, and writes the
+// signed transaction to a file. It does not contact the sequencer, so it can
+// be used on a machine without network access.
+func SignTx(opts SignTxOpts) (*SignTxResponse, error) {
+	tx := &txproto.TransactionBody{}
+	if err := ReadProtoFile(opts.InputPath, tx); err != nil {
+		log.WithError(err).Error("Error reading unsigned transaction")
+		return &SignTxResponse{}, err
 	}
 
-	// Get current address nonce
 	signer := client.NewSigner(opts.FromKey)
 	fromAddr := signer.Address()
 	addr, err := bech32m.EncodeFromBytes(opts.AddressPrefix, fromAddr)
@@ -411,57 +856,138 @@ func BridgeLock(opts BridgeLockOpts) (*BridgeLockResponse, error) {
 		log.WithError(err).Error("Failed to encode address")
 		return nil, err
 	}
-	nonce, err := c.GetNonce(ctx, addr.String())
+
+	// sign transaction
+	signed, err := signer.SignTransaction(tx)
 	if err != nil {
-		log.WithError(err).Error("Error getting nonce")
-		return &BridgeLockResponse{}, err
+		log.WithError(err).Error("Error signing transaction")
+		return &SignTxResponse{}, err
 	}
 
-	tx := &txproto.TransactionBody{
-		Params: &txproto.TransactionParams{
-			ChainId: opts.SequencerChainID,
-			Nonce:   nonce,
-		},
-		Actions: []*txproto.Action{
-			{
-				Value: &txproto.Action_BridgeLock{
-					BridgeLock: &txproto.BridgeLock{
-						To:                      opts.ToAddress,
-						Amount:                  opts.Amount,
-						Asset:                   opts.Asset,
-						FeeAsset:                opts.FeeAsset,
-						DestinationChainAddress: opts.DestinationChainAddress,
-					},
-				},
-			},
-		},
+	if err := WriteProtoFile(opts.OutputPath, signed); err != nil {
+		log.WithError(err).Error("Error writing signed transaction")
+		return &SignTxResponse{}, err
 	}
+	log.Debugf("Signed transaction written to: %s", opts.OutputPath)
 
-	// sign transaction
-	signed, err := signer.SignTransaction(tx)
+	hash, err := TxHash(signed)
 	if err != nil {
-		log.WithError(err).Error("Error signing transaction")
-		return &BridgeLockResponse{}, err
+		log.WithError(err).Error("Error computing transaction hash")
+		return &SignTxResponse{}, err
+	}
+	return &SignTxResponse{
+		Path:     opts.OutputPath,
+		JSONPath: JSONRenderingPath(opts.OutputPath),
+		From:     addr.String(),
+		ChainID:  tx.GetParams().GetChainId(),
+		Nonce:    tx.GetParams().GetNonce(),
+		TxHash:   hash,
+	}, nil
+}
+
+// BroadcastSignedTx reads a signed transaction from a file and broadcasts it
+// to the sequencer. The transaction is decoded first, and is not broadcast if
+// its signature does not verify or it is for another chain.
+func BroadcastSignedTx(opts BroadcastTxOpts) (*BroadcastTxResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), broadcastTimeout(opts.Wait))
+	defer cancel()
+
+	signed := &txproto.Transaction{}
+	if err := ReadProtoFile(opts.InputPath, signed); err != nil {
+		log.WithError(err).Error("Error reading signed transaction")
+		return &BroadcastTxResponse{}, err
+	}
+	decoded, err := DecodeTx(signed, opts.AddressPrefix)
+	if err != nil {
+		log.WithError(err).Error("Error decoding transaction")
+		return &BroadcastTxResponse{}, err
+	}
+	if !decoded.SignatureValid {
+		err := fmt.Errorf("transaction signature does not verify against public key %s", decoded.PublicKey)
+		log.WithError(err).Error("Invalid transaction")
+		return &BroadcastTxResponse{}, err
+	}
+	if decoded.ChainID != opts.SequencerChainID {
+		err := fmt.Errorf("transaction is for chain %q, not %q", decoded.ChainID, opts.SequencerChainID)
+		log.WithError(err).Error("Invalid transaction")
+		return &BroadcastTxResponse{}, err
+	}
+
+	// client
+	log.Debug("Creating CometBFT client with url: ", opts.SequencerURL)
+	c, err := client.NewClient(opts.SequencerURL)
+	if err != nil {
+		log.WithError(err).Error("Error creating sequencer client")
+		return &BroadcastTxResponse{}, err
 	}
 
 	// broadcast tx
-	resp, err := c.BroadcastTx(ctx, signed, opts.IsAsync)
+	resp, err := c.BroadcastTx(ctx, signed, opts.Wait)
 	if err != nil {
 		log.WithError(err).Error("Error broadcasting transaction")
-		return &BridgeLockResponse{}, err
+		return &BroadcastTxResponse{}, err
 	}
 	log.Debugf("Broadcast response: %v", resp)
 
 	// response
 	hash := hex.EncodeToString(resp.Hash)
-	tr := &BridgeLockResponse{
-		From:   addr.String(),
-		To:     opts.ToAddress.Bech32M,
-		Nonce:  nonce,
-		Amount: opts.Amount.String(),
-		TxHash: hash,
+	actions := make([]string, len(decoded.Actions))
+	for i, action := range decoded.Actions {
+		actions[i] = action.Type
+	}
+	tr := &BroadcastTxResponse{
+		From:    decoded.From,
+		Nonce:   decoded.Nonce,
+		Actions: actions,
+		TxHash:  hash,
 	}
 
-	log.Debugf("Transfer hash: %v", hash)
+	log.Debugf("Transaction hash: %v", hash)
 	return tr, nil
 }
+
+// GetTxStatus looks up a transaction by hash and returns its inclusion height,
+// execution result and decoded actions.
+func GetTxStatus(opts TxStatusOpts) (*TxStatusResponse, error) {
+	log.Debug("Creating CometBFT client with url: ", opts.SequencerURL)
+
+	hash, err := hex.DecodeString(strings.TrimPrefix(opts.TxHash, "0x"))
+	if err != nil {
+		log.WithError(err).Error("Error decoding transaction hash")
+		return &TxStatusResponse{}, err
+	}
+
+	c, err := client.NewClient(opts.SequencerURL)
+	if err != nil {
+		log.WithError(err).Error("Error creating sequencer client")
+		return &TxStatusResponse{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	res, err := c.GetTx(ctx, hash)
+	if err != nil {
+		log.WithError(err).Error("Error getting transaction")
+		return &TxStatusResponse{}, err
+	}
+
+	decoded, err := DecodeTxBytes(res.Tx, opts.AddressPrefix)
+	if err != nil {
+		log.WithError(err).Error("Error decoding transaction")
+		return &TxStatusResponse{}, err
+	}
+
+	log.Debug("Retrieved transaction at block height: ", res.Height)
+	return &TxStatusResponse{
+		TxHash:  hex.EncodeToString(res.Hash),
+		Height:  res.Height,
+		Index:   res.Index,
+		Code:    res.TxResult.Code,
+		Log:     res.TxResult.Log,
+		Events:  res.TxResult.Events,
+		From:    decoded.From,
+		Nonce:   decoded.Nonce,
+		Actions: decoded.Actions,
+	}, nil
+}
@@ -0,0 +1,101 @@
+package sequencer
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	txproto "buf.build/gen/go/astria/protocol-apis/protocolbuffers/go/astria/protocol/transaction/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// TransactionBodyTypeURL is the type URL of a TransactionBody packed into the
+// body of a signed Transaction.
+const TransactionBodyTypeURL = "/astria.protocol.transaction.v1.TransactionBody"
+
+// isJSONPath returns true if the path should be read as JSON.
+func isJSONPath(path string) bool {
+	return strings.EqualFold(filepath.Ext(path), ".json")
+}
+
+// JSONRenderingPath returns the path WriteProtoFile writes the JSON rendering
+// of the message written to path to.
+func JSONRenderingPath(path string) string {
+	return path + ".json"
+}
+
+// WriteProtoFile writes a protobuf message to the given path as protobuf
+// binary, which is the format transactions are signed and broadcast in. A
+// rendering of the message with the canonical protobuf JSON mapping is
+// written alongside it, to JSONRenderingPath(path), for reviewing its
+// contents.
+func WriteProtoFile(path string, m proto.Message) error {
+	if isJSONPath(path) {
+		return fmt.Errorf("%s: messages are written as protobuf binary, use a path without a .json extension", path)
+	}
+	data, err := proto.Marshal(m)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", path, err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return err
+	}
+
+	jsonPath := JSONRenderingPath(path)
+	data, err = protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(m)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", jsonPath, err)
+	}
+	return os.WriteFile(jsonPath, data, 0644)
+}
+
+// ReadProtoFile reads a protobuf message from the given path into m. Paths
+// with a `.json` extension, such as the JSON renderings written by
+// WriteProtoFile, are read as protobuf JSON, all other paths as protobuf
+// binary.
+func ReadProtoFile(path string, m proto.Message) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if isJSONPath(path) {
+		err = protojson.Unmarshal(data, m)
+	} else {
+		err = proto.Unmarshal(data, m)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to decode %s: %w", path, err)
+	}
+	return nil
+}
+
+// UnpackTransactionBody extracts the TransactionBody from a signed
+// Transaction.
+func UnpackTransactionBody(tx *txproto.Transaction) (*txproto.TransactionBody, error) {
+	if tx.GetBody() == nil {
+		return nil, fmt.Errorf("transaction has no body")
+	}
+	if tx.Body.TypeUrl != TransactionBodyTypeURL {
+		return nil, fmt.Errorf("unexpected transaction body type: %s", tx.Body.TypeUrl)
+	}
+	body := &txproto.TransactionBody{}
+	if err := proto.Unmarshal(tx.Body.Value, body); err != nil {
+		return nil, fmt.Errorf("failed to decode transaction body: %w", err)
+	}
+	return body, nil
+}
+
+// TxHash returns the hex encoded hash CometBFT uses to identify the
+// transaction, ie. the sha256 hash of the encoded transaction.
+func TxHash(tx *txproto.Transaction) (string, error) {
+	bytes, err := proto.Marshal(tx)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(bytes)
+	return hex.EncodeToString(hash[:]), nil
+}
@@ -0,0 +1,88 @@
+package sequencer
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"path/filepath"
+	"testing"
+
+	txproto "buf.build/gen/go/astria/protocol-apis/protocolbuffers/go/astria/protocol/transaction/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+const testPrivKey = "2bd806c97f0e00af1a1fc3328fa763a9269723c8db8fac4f93af71db186d6e90"
+
+func testBody(t *testing.T) *txproto.TransactionBody {
+	manifest := TxManifest{Actions: []ManifestAction{
+		{Type: ManifestActionTransfer, To: testOtherAddress, Amount: "1000"},
+	}}
+	actions, err := manifest.ToActions(testDefaults)
+	require.NoError(t, err)
+	return &txproto.TransactionBody{
+		Params:  &txproto.TransactionParams{ChainId: "test-chain", Nonce: 7},
+		Actions: actions,
+	}
+}
+
+func TestProtoFileRoundTrip(t *testing.T) {
+	body := testBody(t)
+	path := filepath.Join(t.TempDir(), "body.pb")
+	require.NoError(t, WriteProtoFile(path, body))
+
+	for _, name := range []string{path, JSONRenderingPath(path)} {
+		read := &txproto.TransactionBody{}
+		require.NoError(t, ReadProtoFile(name, read))
+		assert.True(t, proto.Equal(body, read), "transaction body should survive a round trip through %s", name)
+	}
+
+	assert.Error(t, WriteProtoFile(filepath.Join(t.TempDir(), "body.json"), body), "JSON paths should be rejected")
+}
+
+func TestCreateAndSignTx(t *testing.T) {
+	dir := t.TempDir()
+	bodyPath := filepath.Join(dir, "body.pb")
+	txPath := filepath.Join(dir, "tx.pb")
+
+	created, err := CreateTx(CreateTxOpts{
+		SequencerChainID: "test-chain",
+		Nonce:            7,
+		Actions:          testBody(t).Actions,
+		OutputPath:       bodyPath,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"transfer"}, created.Actions)
+	assert.Equal(t, bodyPath+".json", created.JSONPath)
+	assert.FileExists(t, created.JSONPath, "a JSON rendering should be written alongside the body")
+
+	keyBytes, err := hex.DecodeString(testPrivKey)
+	require.NoError(t, err)
+	signed, err := SignTx(SignTxOpts{
+		AddressPrefix: "astria",
+		FromKey:       ed25519.NewKeyFromSeed(keyBytes),
+		InputPath:     bodyPath,
+		OutputPath:    txPath,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, testAddress, signed.From)
+	assert.Equal(t, uint32(7), signed.Nonce)
+
+	tx := &txproto.Transaction{}
+	require.NoError(t, ReadProtoFile(txPath, tx))
+	assert.True(t, ed25519.Verify(tx.PublicKey, tx.Body.Value, tx.Signature), "signature should verify")
+
+	hash, err := TxHash(tx)
+	require.NoError(t, err)
+	assert.Equal(t, signed.TxHash, hash)
+
+	body, err := UnpackTransactionBody(tx)
+	require.NoError(t, err)
+	assert.Equal(t, "test-chain", body.Params.ChainId)
+	assert.Len(t, body.Actions, 1)
+}
+
+func TestUnpackTransactionBodyInvalid(t *testing.T) {
+	_, err := UnpackTransactionBody(&txproto.Transaction{})
+	assert.Error(t, err, "missing body should error")
+}
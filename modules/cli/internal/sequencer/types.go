@@ -4,11 +4,17 @@ import (
 	"crypto/ed25519"
 	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"math/big"
 	"strconv"
+	"strings"
 
 	primproto "buf.build/gen/go/astria/primitives/protocolbuffers/go/astria/primitive/v1"
+	txproto "buf.build/gen/go/astria/protocol-apis/protocolbuffers/go/astria/protocol/transaction/v1"
 	"github.com/astriaorg/astria-cli-go/modules/bech32m"
+	"github.com/astriaorg/astria-cli-go/modules/go-sequencer-client/client"
+	sequencerblockv1 "github.com/astriaorg/astria-cli-go/modules/go-sequencer-client/proto/astria/sequencerblock/v1"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
 	coretypes "github.com/cometbft/cometbft/rpc/core/types"
 	log "github.com/sirupsen/logrus"
 )
@@ -100,6 +106,9 @@ type BalancesResponse []*Balance
 type Balance struct {
 	Denom   string   `json:"denom"`
 	Balance *big.Int `json:"balance"`
+	// Display is the balance in the asset's display unit, ie. "1.5 TIA". It
+	// is shown instead of the balance in base units if set.
+	Display string `json:"display,omitempty"`
 }
 
 func (br *BalancesResponse) JSON() ([]byte, error) {
@@ -113,35 +122,124 @@ func (br *BalancesResponse) TableHeader() []string {
 func (br *BalancesResponse) TableRows() [][]string {
 	rows := make([][]string, len(*br))
 	for i, balance := range *br {
-		rows[i] = []string{balance.Denom, balance.Balance.String()}
+		amount := balance.Balance.String()
+		if balance.Display != "" {
+			amount = balance.Display
+		}
+		rows[i] = []string{balance.Denom, amount}
 	}
 	return rows
 }
 
 // BlockOpts are the options for the GetBlock function.
 type BlockOpts struct {
+	// AddressPrefix is the prefix used to display signer addresses
+	AddressPrefix string
 	// SequencerURL is the URL of the sequencer
 	SequencerURL string
 	// BlockHeight is the height of the block to get
 	BlockHeight int64
+	// Filter selects which of the block's transactions are returned
+	Filter DecodedTxFilter
 }
 
-// BlockResponse is the response of the GetBlock function.
+// BlockResponse is the response of the GetBlock function. Its table output
+// shows the decoded transactions, and its JSON output is the block as
+// returned by CometBFT. Use DecodedBlockResponse for the decoded transactions
+// as JSON.
 type BlockResponse struct {
-	Block *coretypes.ResultBlock `json:"block"`
+	// Block is the raw block as returned by CometBFT
+	Block *coretypes.ResultBlock `json:"-"`
+	// Height is the height of the block
+	Height int64 `json:"height"`
+	// Hash is the hex encoded hash of the block
+	Hash string `json:"hash"`
+	// Time is the block's timestamp
+	Time string `json:"time"`
+	// Proposer is the hex encoded address of the block's proposer
+	Proposer string `json:"proposer"`
+	// Txs are the block's transactions that matched the filter
+	Txs []*BlockTx `json:"txs"`
+}
+
+// Names of the rollup commitments at the start of each block.
+const (
+	CommitmentRollupDatasRoot = "rollupDatasRoot"
+	CommitmentRollupIdsRoot   = "rollupIdsRoot"
+)
+
+// BlockTx is a decoded transaction within a block.
+type BlockTx struct {
+	// Index is the index of the transaction within the block
+	Index int `json:"index"`
+	// Commitment is set for the rollup commitments at the start of each
+	// block, to either CommitmentRollupDatasRoot or CommitmentRollupIdsRoot
+	Commitment string `json:"commitment,omitempty"`
+	// Root is the hex encoded Merkle root of a commitment
+	Root string `json:"root,omitempty"`
+	// Error is set if the transaction could not be decoded
+	Error string `json:"error,omitempty"`
+	DecodedTxResponse
 }
 
 func (br *BlockResponse) JSON() ([]byte, error) {
 	return json.MarshalIndent(br.Block, "", "  ")
-
 }
 
 func (br *BlockResponse) TableHeader() []string {
-	return []string{"Block"}
+	return []string{"Index", "TxHash", "From", "Nonce", "Action", "Details"}
 }
 
 func (br *BlockResponse) TableRows() [][]string {
-	data, err := json.MarshalIndent(br.Block, "", "  ")
+	rows := [][]string{}
+	for _, tx := range br.Txs {
+		index := strconv.Itoa(tx.Index)
+		if tx.Commitment != "" {
+			rows = append(rows, []string{index, tx.TxHash, "", "", "commitment", tx.Commitment + "=" + tx.Root})
+			continue
+		}
+		if tx.Error != "" {
+			rows = append(rows, []string{index, tx.TxHash, "", "", "", tx.Error})
+			continue
+		}
+		for i, action := range tx.Actions {
+			// only show the transaction's columns on its first action
+			if i == 0 {
+				rows = append(rows, []string{index, tx.TxHash, tx.From, strconv.Itoa(int(tx.Nonce)), action.Type, action.Summary()})
+			} else {
+				rows = append(rows, []string{"", "", "", "", action.Type, action.Summary()})
+			}
+		}
+	}
+	return rows
+}
+
+// DecodedBlockResponse prints a block with its transactions decoded, in both
+// table and JSON output.
+type DecodedBlockResponse struct {
+	*BlockResponse
+}
+
+func (dbr *DecodedBlockResponse) JSON() ([]byte, error) {
+	return json.MarshalIndent(dbr.BlockResponse, "", "  ")
+}
+
+// RawBlockResponse prints a block exactly as returned by CometBFT.
+type RawBlockResponse struct {
+	Block *coretypes.ResultBlock `json:"block"`
+}
+
+func (rbr *RawBlockResponse) JSON() ([]byte, error) {
+	return json.MarshalIndent(rbr.Block, "", "  ")
+
+}
+
+func (rbr *RawBlockResponse) TableHeader() []string {
+	return []string{"Block"}
+}
+
+func (rbr *RawBlockResponse) TableRows() [][]string {
+	data, err := json.MarshalIndent(rbr.Block, "", "  ")
 	if err != nil {
 		log.Debug("Error marshalling block to JSON")
 	}
@@ -192,8 +290,12 @@ func (nr *NonceResponse) TableRows() [][]string {
 
 // InitBridgeOpts are the options for the InitBridge function.
 type InitBridgeOpts struct {
-	// Choose to wait for the transaction to be included in a block.
-	IsAsync bool
+	// Wait configures how long to wait for the transaction after it is
+	// broadcast.
+	Wait client.WaitOptions
+	// UseNonceManager reserves the transaction's nonce with the nonce
+	// manager instead of using the sequencer's nonce.
+	UseNonceManager bool
 	// AddressPrefix is the prefix that will be used when generating the address
 	// from the FromKey private key.
 	AddressPrefix string
@@ -239,8 +341,12 @@ func (nr *InitBridgeResponse) TableRows() [][]string {
 
 // BridgeLockOpts are the options for the BridgeLock function.
 type BridgeLockOpts struct {
-	// Choose to wait for the transaction to be included in a block.
-	IsAsync bool
+	// Wait configures how long to wait for the transaction after it is
+	// broadcast.
+	Wait client.WaitOptions
+	// UseNonceManager reserves the transaction's nonce with the nonce
+	// manager instead of using the sequencer's nonce.
+	UseNonceManager bool
 	// AddressPrefix is the prefix that will be used when generating the address
 	// from the FromKey private key.
 	AddressPrefix string
@@ -290,10 +396,138 @@ func (nr *BridgeLockResponse) TableRows() [][]string {
 	}
 }
 
+// BridgeUnlockOpts are the options for the BridgeUnlock function.
+type BridgeUnlockOpts struct {
+	// Wait configures how long to wait for the transaction after it is
+	// broadcast.
+	Wait client.WaitOptions
+	// UseNonceManager reserves the transaction's nonce with the nonce
+	// manager instead of using the sequencer's nonce.
+	UseNonceManager bool
+	// AddressPrefix is the prefix that will be used when generating the address
+	// from the FromKey private key.
+	AddressPrefix string
+	// SequencerURL is the URL of the sequencer
+	SequencerURL string
+	// FromKey is the private key of the bridge account's withdrawer
+	FromKey ed25519.PrivateKey
+	// Amount is the amount to be unlocked
+	Amount *primproto.Uint128
+	// ToAddress is the address on the sequencer to withdraw funds to
+	ToAddress *primproto.Address
+	// BridgeAddress is the address of the bridge account to withdraw from
+	BridgeAddress *primproto.Address
+	// SequencerChainID is the ID of the sequencer chain to unlock asset on
+	SequencerChainID string
+	// FeeAsset is the name of the asset to use for the transaction fee
+	FeeAsset string
+	// Memo is optional identifying information about the unlock
+	Memo string
+	// RollupBlockNumber is the block number on the rollup that triggered the
+	// withdrawal
+	RollupBlockNumber uint64
+	// RollupWithdrawalEventId is the identifier of the rollup event that
+	// triggered the withdrawal, such as a transaction hash
+	RollupWithdrawalEventId string
+}
+
+// BridgeUnlockResponse is the response of the BridgeUnlock function.
+type BridgeUnlockResponse struct {
+	// From is the address of the signer, ie. the bridge account's withdrawer
+	From string `json:"from"`
+	// BridgeAddress is the address of the bridge account funds were withdrawn from
+	BridgeAddress string `json:"bridgeAddress"`
+	// To is the address that received the withdrawn funds
+	To string `json:"to"`
+	// Amount is the amount unlocked
+	Amount string `json:"amount"`
+	// Nonce is the nonce of the transaction
+	Nonce uint32 `json:"nonce"`
+	// TxHash is the hash of the transaction
+	TxHash string `json:"txHash"`
+}
+
+func (bur *BridgeUnlockResponse) JSON() ([]byte, error) {
+	return json.MarshalIndent(bur, "", "  ")
+}
+
+func (bur *BridgeUnlockResponse) TableHeader() []string {
+	return []string{"From", "BridgeAddress", "To", "Amount", "Nonce", "TxHash"}
+}
+
+func (bur *BridgeUnlockResponse) TableRows() [][]string {
+	return [][]string{
+		{bur.From, bur.BridgeAddress, bur.To, bur.Amount, strconv.Itoa(int(bur.Nonce)), bur.TxHash},
+	}
+}
+
+// BridgeSudoChangeOpts are the options for the BridgeSudoChange function.
+type BridgeSudoChangeOpts struct {
+	// Wait configures how long to wait for the transaction after it is
+	// broadcast.
+	Wait client.WaitOptions
+	// UseNonceManager reserves the transaction's nonce with the nonce
+	// manager instead of using the sequencer's nonce.
+	UseNonceManager bool
+	// AddressPrefix is the prefix that will be used when generating the address
+	// from the FromKey private key.
+	AddressPrefix string
+	// SequencerURL is the URL of the sequencer
+	SequencerURL string
+	// FromKey is the private key of the bridge account's current sudo address
+	FromKey ed25519.PrivateKey
+	// SequencerChainID is the ID of the sequencer chain
+	SequencerChainID string
+	// FeeAsset is the name of the asset to use for the transaction fee
+	FeeAsset string
+	// BridgeAddress is the address of the bridge account to update
+	BridgeAddress *primproto.Address
+	// NewSudoAddress is the new sudo address of the bridge account. The sudo
+	// address is left unchanged if this is nil.
+	NewSudoAddress *primproto.Address
+	// NewWithdrawerAddress is the new withdrawer address of the bridge
+	// account. The withdrawer address is left unchanged if this is nil.
+	NewWithdrawerAddress *primproto.Address
+}
+
+// BridgeSudoChangeResponse is the response of the BridgeSudoChange function.
+type BridgeSudoChangeResponse struct {
+	// From is the address of the signer
+	From string `json:"from"`
+	// BridgeAddress is the address of the bridge account that was updated
+	BridgeAddress string `json:"bridgeAddress"`
+	// NewSudoAddress is the new sudo address, empty if unchanged
+	NewSudoAddress string `json:"newSudoAddress"`
+	// NewWithdrawerAddress is the new withdrawer address, empty if unchanged
+	NewWithdrawerAddress string `json:"newWithdrawerAddress"`
+	// Nonce is the nonce of the transaction
+	Nonce uint32 `json:"nonce"`
+	// TxHash is the hash of the transaction
+	TxHash string `json:"txHash"`
+}
+
+func (bsr *BridgeSudoChangeResponse) JSON() ([]byte, error) {
+	return json.MarshalIndent(bsr, "", "  ")
+}
+
+func (bsr *BridgeSudoChangeResponse) TableHeader() []string {
+	return []string{"From", "BridgeAddress", "NewSudoAddress", "NewWithdrawerAddress", "Nonce", "TxHash"}
+}
+
+func (bsr *BridgeSudoChangeResponse) TableRows() [][]string {
+	return [][]string{
+		{bsr.From, bsr.BridgeAddress, bsr.NewSudoAddress, bsr.NewWithdrawerAddress, strconv.Itoa(int(bsr.Nonce)), bsr.TxHash},
+	}
+}
+
 // TransferOpts are the options for the Transfer function.
 type TransferOpts struct {
-	// Choose to wait for the transaction to be included in a block.
-	IsAsync bool
+	// Wait configures how long to wait for the transaction after it is
+	// broadcast.
+	Wait client.WaitOptions
+	// UseNonceManager reserves the transaction's nonce with the nonce
+	// manager instead of using the sequencer's nonce.
+	UseNonceManager bool
 	// AddressPrefix is the prefix that will be used when generating the address
 	// from the FromKey private key.
 	AddressPrefix string
@@ -342,8 +576,12 @@ func (tr *TransferResponse) TableRows() [][]string {
 }
 
 type IbcTransferOpts struct {
-	// Choose to wait for the transaction to be included in a block.
-	IsAsync bool
+	// Wait configures how long to wait for the transaction after it is
+	// broadcast.
+	Wait client.WaitOptions
+	// UseNonceManager reserves the transaction's nonce with the nonce
+	// manager instead of using the sequencer's nonce.
+	UseNonceManager bool
 	// AddressPrefix is the prefix that will be used when generating the address
 	// from the FromKey private key.
 	AddressPrefix string
@@ -395,8 +633,12 @@ func (tr *IbcTransferResponse) TableRows() [][]string {
 }
 
 type FeeAssetOpts struct {
-	// Choose to wait for the transaction to be included in a block.
-	IsAsync bool
+	// Wait configures how long to wait for the transaction after it is
+	// broadcast.
+	Wait client.WaitOptions
+	// UseNonceManager reserves the transaction's nonce with the nonce
+	// manager instead of using the sequencer's nonce.
+	UseNonceManager bool
 	// AddressPrefix is the prefix that will be used when generating the address
 	// from the FromKey private key.
 	AddressPrefix string
@@ -436,8 +678,12 @@ func (far *FeeAssetResponse) TableRows() [][]string {
 }
 
 type IBCRelayerOpts struct {
-	// Choose to wait for the transaction to be included in a block.
-	IsAsync bool
+	// Wait configures how long to wait for the transaction after it is
+	// broadcast.
+	Wait client.WaitOptions
+	// UseNonceManager reserves the transaction's nonce with the nonce
+	// manager instead of using the sequencer's nonce.
+	UseNonceManager bool
 	// AddressPrefix is the prefix that will be used when generating the address
 	// from the FromKey private key.
 	AddressPrefix string
@@ -477,8 +723,12 @@ func (i *IBCRelayerResponse) TableRows() [][]string {
 }
 
 type ChangeSudoAddressOpts struct {
-	// Choose to wait for the transaction to be included in a block.
-	IsAsync bool
+	// Wait configures how long to wait for the transaction after it is
+	// broadcast.
+	Wait client.WaitOptions
+	// UseNonceManager reserves the transaction's nonce with the nonce
+	// manager instead of using the sequencer's nonce.
+	UseNonceManager bool
 	// AddressPrefix is the prefix that will be used when generating the address
 	// from the FromKey private key.
 	AddressPrefix string
@@ -518,8 +768,12 @@ func (c *ChangeSudoAddressResponse) TableRows() [][]string {
 }
 
 type UpdateValidatorOpts struct {
-	// Choose to wait for the transaction to be included in a block.
-	IsAsync bool
+	// Wait configures how long to wait for the transaction after it is
+	// broadcast.
+	Wait client.WaitOptions
+	// UseNonceManager reserves the transaction's nonce with the nonce
+	// manager instead of using the sequencer's nonce.
+	UseNonceManager bool
 	// AddressPrefix is the prefix that will be used when generating the address
 	// from the FromKey private key.
 	AddressPrefix string
@@ -561,3 +815,1234 @@ func (uv *UpdateValidatorResponse) TableRows() [][]string {
 		{uv.From, strconv.Itoa(int(uv.Nonce)), uv.PubKey, uv.Power, uv.TxHash},
 	}
 }
+
+// SubmitRollupDataOpts are the options for the SubmitRollupData function.
+type SubmitRollupDataOpts struct {
+	// Wait configures how long to wait for the transaction after it is
+	// broadcast.
+	Wait client.WaitOptions
+	// UseNonceManager reserves the transaction's nonce with the nonce
+	// manager instead of using the sequencer's nonce.
+	UseNonceManager bool
+	// AddressPrefix is the prefix that will be used when generating the address
+	// from the FromKey private key.
+	AddressPrefix string
+	// SequencerURL is the URL of the sequencer
+	SequencerURL string
+	// FromKey is the private key of the sender
+	FromKey ed25519.PrivateKey
+	// SequencerChainID is the chain ID of the sequencer
+	SequencerChainID string
+	// FeeAsset is the name of the asset to use for the transaction fee
+	FeeAsset string
+	// RollupName is the name of the rollup the data is submitted to. The
+	// rollup ID is derived from this name.
+	RollupName string
+	// Data is the raw data to submit to the rollup
+	Data []byte
+}
+
+// SubmitRollupDataResponse is the response of the SubmitRollupData function.
+type SubmitRollupDataResponse struct {
+	// From is the address of the sender
+	From string `json:"from"`
+	// RollupName is the name of the rollup the data was submitted to
+	RollupName string `json:"rollupName"`
+	// RollupID is the hex encoded ID of the rollup the data was submitted to
+	RollupID string `json:"rollupID"`
+	// DataSize is the number of bytes submitted
+	DataSize int `json:"dataSize"`
+	// Nonce is the nonce of the transaction
+	Nonce uint32 `json:"nonce"`
+	// TxHash is the hash of the transaction
+	TxHash string `json:"txHash"`
+}
+
+func (sr *SubmitRollupDataResponse) JSON() ([]byte, error) {
+	return json.MarshalIndent(sr, "", "  ")
+}
+
+func (sr *SubmitRollupDataResponse) TableHeader() []string {
+	return []string{"From", "RollupName", "RollupID", "DataSize", "Nonce", "TxHash"}
+}
+
+func (sr *SubmitRollupDataResponse) TableRows() [][]string {
+	return [][]string{
+		{sr.From, sr.RollupName, sr.RollupID, strconv.Itoa(sr.DataSize), strconv.Itoa(int(sr.Nonce)), sr.TxHash},
+	}
+}
+
+// BuildTxOpts are the options for the BuildAndSendTx function.
+type BuildTxOpts struct {
+	// Wait configures how long to wait for the transaction after it is
+	// broadcast.
+	Wait client.WaitOptions
+	// UseNonceManager reserves the transaction's nonce with the nonce
+	// manager instead of using the sequencer's nonce.
+	UseNonceManager bool
+	// AddressPrefix is the prefix that will be used when generating the address
+	// from the FromKey private key.
+	AddressPrefix string
+	// SequencerURL is the URL of the sequencer
+	SequencerURL string
+	// FromKey is the private key of the signer
+	FromKey ed25519.PrivateKey
+	// SequencerChainID is the chain ID of the sequencer
+	SequencerChainID string
+	// Actions are the actions to include in the transaction, in order
+	Actions []*txproto.Action
+}
+
+// BuildTxResponse is the response of the BuildAndSendTx function.
+type BuildTxResponse struct {
+	// From is the address of the signer
+	From string `json:"from"`
+	// Nonce is the nonce of the transaction
+	Nonce uint32 `json:"nonce"`
+	// Actions are the types of the actions in the transaction, in order
+	Actions []string `json:"actions"`
+	// TxHash is the hash of the transaction
+	TxHash string `json:"txHash"`
+}
+
+func (btr *BuildTxResponse) JSON() ([]byte, error) {
+	return json.MarshalIndent(btr, "", "  ")
+}
+
+func (btr *BuildTxResponse) TableHeader() []string {
+	return []string{"From", "Nonce", "Actions", "TxHash"}
+}
+
+func (btr *BuildTxResponse) TableRows() [][]string {
+	return [][]string{
+		{btr.From, strconv.Itoa(int(btr.Nonce)), strings.Join(btr.Actions, ","), btr.TxHash},
+	}
+}
+
+// CreateTxOpts are the options for the CreateTx function.
+type CreateTxOpts struct {
+	// SequencerChainID is the chain ID of the sequencer the transaction is for
+	SequencerChainID string
+	// Nonce is the nonce of the signer the transaction will use
+	Nonce uint32
+	// Actions are the actions to include in the transaction, in order
+	Actions []*txproto.Action
+	// OutputPath is the path the unsigned TransactionBody is written to
+	OutputPath string
+}
+
+// CreateTxResponse is the response of the CreateTx function.
+type CreateTxResponse struct {
+	// Path is the path the unsigned transaction was written to
+	Path string `json:"path"`
+	// JSONPath is the path the JSON rendering of the unsigned transaction
+	// was written to
+	JSONPath string `json:"jsonPath"`
+	// ChainID is the chain ID of the transaction
+	ChainID string `json:"chainID"`
+	// Nonce is the nonce of the transaction
+	Nonce uint32 `json:"nonce"`
+	// Actions are the types of the actions in the transaction, in order
+	Actions []string `json:"actions"`
+}
+
+func (ctr *CreateTxResponse) JSON() ([]byte, error) {
+	return json.MarshalIndent(ctr, "", "  ")
+}
+
+func (ctr *CreateTxResponse) TableHeader() []string {
+	return []string{"Path", "JSONPath", "ChainID", "Nonce", "Actions"}
+}
+
+func (ctr *CreateTxResponse) TableRows() [][]string {
+	return [][]string{
+		{ctr.Path, ctr.JSONPath, ctr.ChainID, strconv.Itoa(int(ctr.Nonce)), strings.Join(ctr.Actions, ",")},
+	}
+}
+
+// SignTxOpts are the options for the SignTx function.
+type SignTxOpts struct {
+	// AddressPrefix is the prefix that will be used when generating the address
+	// from the FromKey private key.
+	AddressPrefix string
+	// FromKey is the private key of the signer
+	FromKey ed25519.PrivateKey
+	// InputPath is the path of the unsigned TransactionBody to sign
+	InputPath string
+	// OutputPath is the path the signed Transaction is written to
+	OutputPath string
+}
+
+// SignTxResponse is the response of the SignTx function.
+type SignTxResponse struct {
+	// Path is the path the signed transaction was written to
+	Path string `json:"path"`
+	// JSONPath is the path the JSON rendering of the signed transaction was
+	// written to
+	JSONPath string `json:"jsonPath"`
+	// From is the address of the signer
+	From string `json:"from"`
+	// ChainID is the chain ID of the transaction
+	ChainID string `json:"chainID"`
+	// Nonce is the nonce of the transaction
+	Nonce uint32 `json:"nonce"`
+	// TxHash is the hash the transaction will have once broadcast
+	TxHash string `json:"txHash"`
+}
+
+func (str *SignTxResponse) JSON() ([]byte, error) {
+	return json.MarshalIndent(str, "", "  ")
+}
+
+func (str *SignTxResponse) TableHeader() []string {
+	return []string{"Path", "JSONPath", "From", "ChainID", "Nonce", "TxHash"}
+}
+
+func (str *SignTxResponse) TableRows() [][]string {
+	return [][]string{
+		{str.Path, str.JSONPath, str.From, str.ChainID, strconv.Itoa(int(str.Nonce)), str.TxHash},
+	}
+}
+
+// BroadcastTxOpts are the options for the BroadcastSignedTx function.
+type BroadcastTxOpts struct {
+	// Wait configures how long to wait for the transaction after it is
+	// broadcast.
+	Wait client.WaitOptions
+	// AddressPrefix is the prefix used to display the signer's address
+	AddressPrefix string
+	// SequencerURL is the URL of the sequencer
+	SequencerURL string
+	// SequencerChainID is the chain ID of the sequencer. Transactions for
+	// another chain are not broadcast.
+	SequencerChainID string
+	// InputPath is the path of the signed Transaction to broadcast
+	InputPath string
+}
+
+// BroadcastTxResponse is the response of the BroadcastSignedTx function.
+type BroadcastTxResponse struct {
+	// From is the address of the signer
+	From string `json:"from"`
+	// Nonce is the nonce of the transaction
+	Nonce uint32 `json:"nonce"`
+	// Actions are the types of the actions in the transaction, in order
+	Actions []string `json:"actions"`
+	// TxHash is the hash of the transaction
+	TxHash string `json:"txHash"`
+}
+
+func (btr *BroadcastTxResponse) JSON() ([]byte, error) {
+	return json.MarshalIndent(btr, "", "  ")
+}
+
+func (btr *BroadcastTxResponse) TableHeader() []string {
+	return []string{"From", "Nonce", "Actions", "TxHash"}
+}
+
+func (btr *BroadcastTxResponse) TableRows() [][]string {
+	return [][]string{
+		{btr.From, strconv.Itoa(int(btr.Nonce)), strings.Join(btr.Actions, ","), btr.TxHash},
+	}
+}
+
+// DecodeTxOpts are the options for the DecodeTransaction function.
+type DecodeTxOpts struct {
+	// AddressPrefix is the prefix used to display the signer's address
+	AddressPrefix string
+	// Input is the encoded transaction, or `@path` of a file containing it
+	Input string
+	// Encoding is the encoding of Input, either TxEncodingHex or
+	// TxEncodingBase64. If empty, it is detected from Input.
+	Encoding string
+}
+
+// DecodedTxResponse is a human readable representation of a signed
+// transaction.
+type DecodedTxResponse struct {
+	// TxHash is the hash of the transaction
+	TxHash string `json:"txHash"`
+	// From is the address derived from the transaction's public key
+	From string `json:"from"`
+	// PublicKey is the hex encoded public key of the signer
+	PublicKey string `json:"publicKey"`
+	// SignatureValid is true if the signature verifies against the public key
+	SignatureValid bool `json:"signatureValid"`
+	// ChainID is the chain ID of the transaction
+	ChainID string `json:"chainID"`
+	// Nonce is the nonce of the transaction
+	Nonce uint32 `json:"nonce"`
+	// Actions are the decoded actions of the transaction, in order
+	Actions []DecodedAction `json:"actions"`
+}
+
+func (dtr *DecodedTxResponse) JSON() ([]byte, error) {
+	return json.MarshalIndent(dtr, "", "  ")
+}
+
+func (dtr *DecodedTxResponse) TableHeader() []string {
+	return []string{"Field", "Value"}
+}
+
+func (dtr *DecodedTxResponse) TableRows() [][]string {
+	signature := "valid"
+	if !dtr.SignatureValid {
+		signature = "INVALID"
+	}
+	rows := [][]string{
+		{"TxHash", dtr.TxHash},
+		{"From", dtr.From},
+		{"PublicKey", dtr.PublicKey},
+		{"Signature", signature},
+		{"ChainID", dtr.ChainID},
+		{"Nonce", strconv.Itoa(int(dtr.Nonce))},
+	}
+	for i, action := range dtr.Actions {
+		rows = append(rows, []string{
+			fmt.Sprintf("Action %d (%s)", i, action.Type),
+			action.Summary(),
+		})
+	}
+	return rows
+}
+
+// TxStatusOpts are the options for the GetTxStatus function.
+type TxStatusOpts struct {
+	// AddressPrefix is the prefix used to display the signer's address
+	AddressPrefix string
+	// SequencerURL is the URL of the sequencer
+	SequencerURL string
+	// TxHash is the hex encoded hash of the transaction
+	TxHash string
+}
+
+// TxStatusResponse is the response of the GetTxStatus function.
+type TxStatusResponse struct {
+	// TxHash is the hash of the transaction
+	TxHash string `json:"txHash"`
+	// Height is the height of the block the transaction was included in
+	Height int64 `json:"height"`
+	// Index is the index of the transaction within the block
+	Index uint32 `json:"index"`
+	// Code is the result code of the transaction, 0 means success
+	Code uint32 `json:"code"`
+	// Log is the log output of the transaction's execution
+	Log string `json:"log"`
+	// Events are the events emitted by the transaction's execution
+	Events []abcitypes.Event `json:"events"`
+	// From is the address of the signer
+	From string `json:"from"`
+	// Nonce is the nonce of the transaction
+	Nonce uint32 `json:"nonce"`
+	// Actions are the decoded actions of the transaction, in order
+	Actions []DecodedAction `json:"actions"`
+}
+
+func (tsr *TxStatusResponse) JSON() ([]byte, error) {
+	return json.MarshalIndent(tsr, "", "  ")
+}
+
+func (tsr *TxStatusResponse) TableHeader() []string {
+	return []string{"Field", "Value"}
+}
+
+func (tsr *TxStatusResponse) TableRows() [][]string {
+	rows := [][]string{
+		{"TxHash", tsr.TxHash},
+		{"Height", strconv.FormatInt(tsr.Height, 10)},
+		{"Index", strconv.Itoa(int(tsr.Index))},
+		{"Code", strconv.Itoa(int(tsr.Code))},
+		{"Log", tsr.Log},
+		{"From", tsr.From},
+		{"Nonce", strconv.Itoa(int(tsr.Nonce))},
+	}
+	for i, action := range tsr.Actions {
+		rows = append(rows, []string{
+			fmt.Sprintf("Action %d (%s)", i, action.Type),
+			action.Summary(),
+		})
+	}
+	for _, event := range tsr.Events {
+		attributes := make([]string, 0, len(event.Attributes))
+		for _, attr := range event.Attributes {
+			attributes = append(attributes, attr.Key+"="+attr.Value)
+		}
+		rows = append(rows, []string{
+			fmt.Sprintf("Event (%s)", event.Type),
+			strings.Join(attributes, " "),
+		})
+	}
+	return rows
+}
+
+// WatchOpts are the options for the WatchBlocks and WatchTxs functions.
+type WatchOpts struct {
+	// AddressPrefix is the prefix used to display signer addresses
+	AddressPrefix string
+	// SequencerURL is the URL of the sequencer
+	SequencerURL string
+	// Address limits transactions to those signed by or referencing the
+	// address. Empty means no filtering.
+	Address string
+	// ActionType limits transactions to those containing an action of the
+	// type, ie. "transfer". Empty means no filtering.
+	ActionType string
+}
+
+// WatchBlockResponse is a single block streamed by the WatchBlocks function.
+type WatchBlockResponse struct {
+	// Height is the height of the block
+	Height int64 `json:"height"`
+	// Time is the block's timestamp
+	Time string `json:"time"`
+	// Hash is the hex encoded hash of the block
+	Hash string `json:"hash"`
+	// Proposer is the hex encoded address of the block's proposer
+	Proposer string `json:"proposer"`
+	// NumTxs is the number of transactions in the block
+	NumTxs int `json:"numTxs"`
+}
+
+func (wbr *WatchBlockResponse) JSON() ([]byte, error) {
+	return json.MarshalIndent(wbr, "", "  ")
+}
+
+func (wbr *WatchBlockResponse) TableHeader() []string {
+	return []string{"Height", "Time", "Hash", "Proposer", "NumTxs"}
+}
+
+func (wbr *WatchBlockResponse) TableRows() [][]string {
+	return [][]string{
+		{strconv.FormatInt(wbr.Height, 10), wbr.Time, wbr.Hash, wbr.Proposer, strconv.Itoa(wbr.NumTxs)},
+	}
+}
+
+// WatchTxResponse is a single transaction streamed by the WatchTxs function.
+type WatchTxResponse struct {
+	// Height is the height of the block the transaction was included in
+	Height int64 `json:"height"`
+	// TxHash is the hash of the transaction
+	TxHash string `json:"txHash"`
+	// Code is the result code of the transaction, 0 means success
+	Code uint32 `json:"code"`
+	// Log is the log output of the transaction's execution
+	Log string `json:"log"`
+	// From is the address of the signer
+	From string `json:"from"`
+	// Nonce is the nonce of the transaction
+	Nonce uint32 `json:"nonce"`
+	// Actions are the decoded actions of the transaction, in order
+	Actions []DecodedAction `json:"actions"`
+}
+
+func (wtr *WatchTxResponse) JSON() ([]byte, error) {
+	return json.MarshalIndent(wtr, "", "  ")
+}
+
+func (wtr *WatchTxResponse) TableHeader() []string {
+	return []string{"Height", "TxHash", "From", "Nonce", "Code", "Actions"}
+}
+
+func (wtr *WatchTxResponse) TableRows() [][]string {
+	actions := make([]string, 0, len(wtr.Actions))
+	for _, action := range wtr.Actions {
+		actions = append(actions, action.Type)
+	}
+	return [][]string{
+		{strconv.FormatInt(wtr.Height, 10), wtr.TxHash, wtr.From, strconv.Itoa(int(wtr.Nonce)), strconv.Itoa(int(wtr.Code)), strings.Join(actions, ",")},
+	}
+}
+
+// HistoryOpts are the options for the GetHistory function.
+type HistoryOpts struct {
+	// AddressPrefix is the prefix used to display signer addresses
+	AddressPrefix string
+	// SequencerURL is the URL of the sequencer
+	SequencerURL string
+	// Address is the bech32m address to find the history of
+	Address string
+	// FromHeight is the first block height to scan
+	FromHeight int64
+	// ToHeight is the last block height to scan. Zero means the latest block.
+	ToHeight int64
+	// Concurrency is the number of blocks to fetch at once
+	Concurrency int
+}
+
+// HistoryEntry is a single action involving the address.
+type HistoryEntry struct {
+	// Height is the height of the block containing the action
+	Height int64 `json:"height"`
+	// TxIndex is the index of the transaction within the block
+	TxIndex int `json:"txIndex"`
+	// TxHash is the hash of the transaction containing the action
+	TxHash string `json:"txHash"`
+	// ActionIndex is the index of the action within the transaction
+	ActionIndex int `json:"actionIndex"`
+	// Roles are how the address is involved in the action, ie. "signer" or
+	// the names of the action's fields holding the address
+	Roles []string `json:"roles"`
+	// Action is the decoded action
+	Action DecodedAction `json:"action"`
+}
+
+// HistoryResponse is the response of the GetHistory function.
+type HistoryResponse struct {
+	// Address is the address the history is for
+	Address string `json:"address"`
+	// FromHeight is the first block height scanned
+	FromHeight int64 `json:"fromHeight"`
+	// ToHeight is the last block height scanned
+	ToHeight int64 `json:"toHeight"`
+	// Entries are the actions involving the address, in chain order
+	Entries []*HistoryEntry `json:"entries"`
+}
+
+func (hr *HistoryResponse) JSON() ([]byte, error) {
+	return json.MarshalIndent(hr, "", "  ")
+}
+
+func (hr *HistoryResponse) TableHeader() []string {
+	return []string{"Height", "TxHash", "Roles", "Action", "Details"}
+}
+
+func (hr *HistoryResponse) TableRows() [][]string {
+	rows := make([][]string, len(hr.Entries))
+	for i, entry := range hr.Entries {
+		rows[i] = []string{
+			strconv.FormatInt(entry.Height, 10),
+			entry.TxHash,
+			strings.Join(entry.Roles, ","),
+			entry.Action.Type,
+			entry.Action.Summary(),
+		}
+	}
+	return rows
+}
+
+// DenomResponse is the response of the GetDenom function.
+type DenomResponse struct {
+	// Height is the height the query was answered at
+	Height uint64 `json:"height"`
+	// AssetID is the hex encoded ID of the asset
+	AssetID string `json:"assetID"`
+	// Denom is the full denom of the asset
+	Denom string `json:"denom"`
+}
+
+func (dr *DenomResponse) JSON() ([]byte, error) {
+	return json.MarshalIndent(dr, "", "  ")
+}
+
+func (dr *DenomResponse) TableHeader() []string {
+	return []string{"AssetID", "Denom", "Height"}
+}
+
+func (dr *DenomResponse) TableRows() [][]string {
+	return [][]string{
+		{dr.AssetID, dr.Denom, strconv.FormatUint(dr.Height, 10)},
+	}
+}
+
+// AllowedFeeAssetsResponse is the response of the GetAllowedFeeAssets
+// function.
+type AllowedFeeAssetsResponse struct {
+	// Height is the height the query was answered at
+	Height uint64 `json:"height"`
+	// FeeAssets are the assets that can be used to pay fees
+	FeeAssets []string `json:"feeAssets"`
+}
+
+func (afr *AllowedFeeAssetsResponse) JSON() ([]byte, error) {
+	return json.MarshalIndent(afr, "", "  ")
+}
+
+func (afr *AllowedFeeAssetsResponse) TableHeader() []string {
+	return []string{"FeeAsset"}
+}
+
+func (afr *AllowedFeeAssetsResponse) TableRows() [][]string {
+	rows := make([][]string, len(afr.FeeAssets))
+	for i, asset := range afr.FeeAssets {
+		rows[i] = []string{asset}
+	}
+	return rows
+}
+
+// TransactionFeeResponse is the response of the GetTransactionFee function.
+type TransactionFeeResponse struct {
+	// Height is the height the query was answered at
+	Height uint64 `json:"height"`
+	// Fees are the fees that would be charged, per fee asset
+	Fees []*Balance `json:"fees"`
+}
+
+func (tfr *TransactionFeeResponse) JSON() ([]byte, error) {
+	return json.MarshalIndent(tfr, "", "  ")
+}
+
+func (tfr *TransactionFeeResponse) TableHeader() []string {
+	return []string{"Denom", "Fee"}
+}
+
+func (tfr *TransactionFeeResponse) TableRows() [][]string {
+	rows := make([][]string, len(tfr.Fees))
+	for i, fee := range tfr.Fees {
+		rows[i] = []string{fee.Denom, fee.Balance.String()}
+	}
+	return rows
+}
+
+// BridgeAccountInfoResponse is the response of the GetBridgeAccountInfo
+// function.
+type BridgeAccountInfoResponse struct {
+	// Height is the height the query was answered at
+	Height uint64 `json:"height"`
+	// Address is the address that was queried
+	Address string `json:"address"`
+	// IsBridge is false if the address is not a bridge account, in which case
+	// the remaining fields are empty
+	IsBridge bool `json:"isBridge"`
+	// RollupID is the hex encoded ID of the rollup the bridge account is for
+	RollupID string `json:"rollupID"`
+	// Asset is the asset the bridge account accepts
+	Asset string `json:"asset"`
+	// SudoAddress is the address allowed to change the bridge account
+	SudoAddress string `json:"sudoAddress"`
+	// WithdrawerAddress is the address allowed to withdraw from the bridge
+	// account
+	WithdrawerAddress string `json:"withdrawerAddress"`
+}
+
+func (bair *BridgeAccountInfoResponse) JSON() ([]byte, error) {
+	return json.MarshalIndent(bair, "", "  ")
+}
+
+func (bair *BridgeAccountInfoResponse) TableHeader() []string {
+	return []string{"Address", "IsBridge", "RollupID", "Asset", "SudoAddress", "WithdrawerAddress"}
+}
+
+func (bair *BridgeAccountInfoResponse) TableRows() [][]string {
+	return [][]string{
+		{bair.Address, strconv.FormatBool(bair.IsBridge), bair.RollupID, bair.Asset, bair.SudoAddress, bair.WithdrawerAddress},
+	}
+}
+
+// BridgeAccountLastTxHashResponse is the response of the
+// GetBridgeAccountLastTxHash function.
+type BridgeAccountLastTxHashResponse struct {
+	// Height is the height the query was answered at
+	Height uint64 `json:"height"`
+	// Address is the address of the bridge account
+	Address string `json:"address"`
+	// TxHash is the hash of the last transaction that used the bridge
+	// account, empty if there is none
+	TxHash string `json:"txHash"`
+}
+
+func (blr *BridgeAccountLastTxHashResponse) JSON() ([]byte, error) {
+	return json.MarshalIndent(blr, "", "  ")
+}
+
+func (blr *BridgeAccountLastTxHashResponse) TableHeader() []string {
+	return []string{"Address", "LastTxHash"}
+}
+
+func (blr *BridgeAccountLastTxHashResponse) TableRows() [][]string {
+	return [][]string{
+		{blr.Address, blr.TxHash},
+	}
+}
+
+// AppInfoResponse is the response of the GetAppInfo function.
+type AppInfoResponse struct {
+	// Data is the application's name
+	Data string `json:"data"`
+	// Version is the application's software version
+	Version string `json:"version"`
+	// AppVersion is the application's protocol version
+	AppVersion uint64 `json:"appVersion"`
+	// LastBlockHeight is the height of the last committed block
+	LastBlockHeight int64 `json:"lastBlockHeight"`
+	// LastBlockAppHash is the hex encoded app hash after the last committed
+	// block
+	LastBlockAppHash string `json:"lastBlockAppHash"`
+}
+
+func (air *AppInfoResponse) JSON() ([]byte, error) {
+	return json.MarshalIndent(air, "", "  ")
+}
+
+func (air *AppInfoResponse) TableHeader() []string {
+	return []string{"Data", "Version", "AppVersion", "LastBlockHeight", "LastBlockAppHash"}
+}
+
+func (air *AppInfoResponse) TableRows() [][]string {
+	return [][]string{
+		{air.Data, air.Version, strconv.FormatUint(air.AppVersion, 10), strconv.FormatInt(air.LastBlockHeight, 10), air.LastBlockAppHash},
+	}
+}
+
+// BridgeInfoOpts are the options for the GetBridgeInfo function.
+type BridgeInfoOpts struct {
+	// AddressPrefix is the prefix used to derive the signer of the last
+	// transaction
+	AddressPrefix string
+	// SequencerURL is the URL of the sequencer to query
+	SequencerURL string
+	// BridgeAddress is the address of the bridge account
+	BridgeAddress string
+}
+
+// BridgeInfoResponse is the response of the GetBridgeInfo function.
+type BridgeInfoResponse struct {
+	// Address is the address of the bridge account
+	Address string `json:"address"`
+	// RollupID is the hex encoded ID of the rollup the bridge account is for
+	RollupID string `json:"rollupID"`
+	// Asset is the asset the bridge account accepts
+	Asset string `json:"asset"`
+	// SudoAddress is the address allowed to change the bridge account
+	SudoAddress string `json:"sudoAddress"`
+	// WithdrawerAddress is the address allowed to withdraw from the bridge
+	// account
+	WithdrawerAddress string `json:"withdrawerAddress"`
+	// LastTxHash is the hash of the last transaction that used the bridge
+	// account, empty if there is none
+	LastTxHash string `json:"lastTxHash"`
+	// LastTxHeight is the height of the last transaction, 0 if it could not
+	// be retrieved
+	LastTxHeight int64 `json:"lastTxHeight,omitempty"`
+	// LastWithdrawal is the withdrawal made by the last transaction, if any
+	LastWithdrawal *DecodedAction `json:"lastWithdrawal,omitempty"`
+}
+
+func (bir *BridgeInfoResponse) JSON() ([]byte, error) {
+	return json.MarshalIndent(bir, "", "  ")
+}
+
+func (bir *BridgeInfoResponse) TableHeader() []string {
+	return []string{"Field", "Value"}
+}
+
+func (bir *BridgeInfoResponse) TableRows() [][]string {
+	rows := [][]string{
+		{"Address", bir.Address},
+		{"RollupID", bir.RollupID},
+		{"Asset", bir.Asset},
+		{"SudoAddress", bir.SudoAddress},
+		{"WithdrawerAddress", bir.WithdrawerAddress},
+		{"LastTxHash", bir.LastTxHash},
+	}
+	if bir.LastTxHeight != 0 {
+		rows = append(rows, []string{"LastTxHeight", strconv.FormatInt(bir.LastTxHeight, 10)})
+	}
+	if bir.LastWithdrawal != nil {
+		rows = append(rows, []string{fmt.Sprintf("LastWithdrawal (%s)", bir.LastWithdrawal.Type), bir.LastWithdrawal.Summary()})
+	}
+	return rows
+}
+
+// DryRunOpts are the options for the DryRun function.
+type DryRunOpts struct {
+	// AddressPrefix is the prefix of the signer's address
+	AddressPrefix string
+	// SequencerURL is the URL of the sequencer
+	SequencerURL string
+	// FromKey is the private key the transaction is signed with
+	FromKey ed25519.PrivateKey
+	// SequencerChainID is the chain ID of the sequencer
+	SequencerChainID string
+	// Actions are the actions of the transaction
+	Actions []*txproto.Action
+}
+
+// DryRunAction is the cost of a single action of a dry run transaction.
+type DryRunAction struct {
+	// Index is the position of the action in the transaction
+	Index int `json:"index"`
+	// Type is the type of the action
+	Type string `json:"type"`
+	// Amount is the amount the action moves out of the signer's account,
+	// if any
+	Amount *Balance `json:"amount,omitempty"`
+	// Fees are the fees charged for the action, per fee asset
+	Fees []*Balance `json:"fees"`
+}
+
+// DryRunCost is the total cost of a dry run transaction in a single asset.
+type DryRunCost struct {
+	Denom string `json:"denom"`
+	// Amount is the sum of the amounts moved by the actions
+	Amount *big.Int `json:"amount"`
+	// Fees is the sum of the fees charged for the actions
+	Fees *big.Int `json:"fees"`
+	// Total is Amount plus Fees
+	Total *big.Int `json:"total"`
+	// Balance is the signer's current balance
+	Balance *big.Int `json:"balance"`
+	// Sufficient is true if Balance covers Total
+	Sufficient bool `json:"sufficient"`
+}
+
+// DryRunResponse is the response of the DryRun function.
+type DryRunResponse struct {
+	// From is the address of the signer
+	From string `json:"from"`
+	// Nonce is the nonce the transaction was signed with
+	Nonce uint32 `json:"nonce"`
+	// TxHash is the hash the transaction would have if broadcast
+	TxHash string `json:"txHash"`
+	// Actions are the costs of each action
+	Actions []*DryRunAction `json:"actions"`
+	// Costs are the total costs per asset
+	Costs []*DryRunCost `json:"costs"`
+	// Sufficient is true if the signer's balances cover every cost
+	Sufficient bool `json:"sufficient"`
+}
+
+func (dr *DryRunResponse) JSON() ([]byte, error) {
+	return json.MarshalIndent(dr, "", "  ")
+}
+
+func (dr *DryRunResponse) TableHeader() []string {
+	return []string{"Item", "Denom", "Amount"}
+}
+
+func (dr *DryRunResponse) TableRows() [][]string {
+	rows := [][]string{
+		{"From", "", dr.From},
+		{"Nonce", "", strconv.FormatUint(uint64(dr.Nonce), 10)},
+		{"TxHash", "", dr.TxHash},
+	}
+	for _, a := range dr.Actions {
+		if a.Amount != nil {
+			rows = append(rows, []string{fmt.Sprintf("Action %d (%s) amount", a.Index, a.Type), a.Amount.Denom, a.Amount.Balance.String()})
+		}
+		for _, fee := range a.Fees {
+			rows = append(rows, []string{fmt.Sprintf("Action %d (%s) fee", a.Index, a.Type), fee.Denom, fee.Balance.String()})
+		}
+	}
+	for _, c := range dr.Costs {
+		rows = append(rows,
+			[]string{"Total", c.Denom, c.Total.String()},
+			[]string{"Balance", c.Denom, c.Balance.String()},
+		)
+		if !c.Sufficient {
+			rows = append(rows, []string{"Shortfall", c.Denom, new(big.Int).Sub(c.Total, c.Balance).String()})
+		}
+	}
+	rows = append(rows, []string{"Sufficient", "", strconv.FormatBool(dr.Sufficient)})
+	return rows
+}
+
+// BatchTransferRow is a single transfer of a batch transfer file.
+type BatchTransferRow struct {
+	// Row is the 1-based position of the transfer in the file
+	Row int
+	// Address is the recipient of the transfer
+	Address string
+	// Amount is the amount to transfer
+	Amount string
+	// Asset is the asset to transfer, empty to use the default asset
+	Asset string
+}
+
+// BatchTransferOpts are the options for the BatchTransfer function.
+type BatchTransferOpts struct {
+	// Wait configures how long to wait for each transaction after it is
+	// broadcast.
+	Wait client.WaitOptions
+	// UseNonceManager reserves the transfers' nonces with the nonce manager
+	// instead of using the sequencer's nonce.
+	UseNonceManager bool
+	// AddressPrefix is the prefix that will be used when generating the address
+	// from the FromKey private key.
+	AddressPrefix string
+	// SequencerURL is the URL of the sequencer
+	SequencerURL string
+	// FromKey is the private key of the sender
+	FromKey ed25519.PrivateKey
+	// SequencerChainID is the chain ID of the sequencer
+	SequencerChainID string
+	// Asset is the asset transferred by rows that do not set an asset
+	Asset string
+	// FeeAsset is the asset used to pay fees
+	FeeAsset string
+	// Rows are the transfers to send
+	Rows []*BatchTransferRow
+	// ResultsPath is the path of the results file to resume from and write
+	// to. Results are not persisted if empty.
+	ResultsPath string
+	// Concurrency is the number of transactions broadcast at once
+	Concurrency int
+	// Retries is the number of times a transient broadcast failure is retried
+	Retries int
+}
+
+// BatchTransferResult is the result of a single transfer of a batch.
+type BatchTransferResult struct {
+	Row     int    `json:"row"`
+	Address string `json:"address"`
+	Amount  string `json:"amount"`
+	Asset   string `json:"asset"`
+	Nonce   uint32 `json:"nonce"`
+	TxHash  string `json:"txHash"`
+	// Status is one of the BatchStatus constants
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+	// SignedTx is the base64 encoded signed transaction. It is recorded in
+	// the results file so the transaction can be broadcast again as is.
+	SignedTx string `json:"-"`
+}
+
+// BatchTransferResponse is the response of the BatchTransfer function.
+type BatchTransferResponse struct {
+	// From is the address of the sender
+	From string `json:"from"`
+	// Results are the results of each transfer, in file order
+	Results []*BatchTransferResult `json:"results"`
+}
+
+func (btr *BatchTransferResponse) JSON() ([]byte, error) {
+	return json.MarshalIndent(btr, "", "  ")
+}
+
+func (btr *BatchTransferResponse) TableHeader() []string {
+	return []string{"Row", "Address", "Amount", "Asset", "Nonce", "TxHash", "Status", "Error"}
+}
+
+func (btr *BatchTransferResponse) TableRows() [][]string {
+	rows := make([][]string, len(btr.Results))
+	for i, r := range btr.Results {
+		rows[i] = []string{strconv.Itoa(r.Row), r.Address, r.Amount, r.Asset, strconv.FormatUint(uint64(r.Nonce), 10), r.TxHash, r.Status, r.Error}
+	}
+	return rows
+}
+
+// ValidatorsOpts are the options for the GetValidators function.
+type ValidatorsOpts struct {
+	// AddressPrefix is the prefix the validator addresses are encoded with
+	AddressPrefix string
+	// SequencerURL is the URL of the sequencer to query
+	SequencerURL string
+	// Height is the height to get the validator set at, 0 for the latest
+	Height int64
+}
+
+// Validator is a member of the sequencer's validator set.
+type Validator struct {
+	// Address is the validator's bech32m address
+	Address string `json:"address"`
+	// PubKey is the validator's hex encoded ed25519 public key
+	PubKey           string `json:"pubKey"`
+	VotingPower      int64  `json:"votingPower"`
+	ProposerPriority int64  `json:"proposerPriority"`
+}
+
+// ValidatorsResponse is the response of the GetValidators function.
+type ValidatorsResponse struct {
+	// Height is the height of the validator set
+	Height           int64        `json:"height"`
+	TotalVotingPower int64        `json:"totalVotingPower"`
+	Validators       []*Validator `json:"validators"`
+}
+
+func (vr *ValidatorsResponse) JSON() ([]byte, error) {
+	return json.MarshalIndent(vr, "", "  ")
+}
+
+func (vr *ValidatorsResponse) TableHeader() []string {
+	return []string{"Address", "VotingPower", "ProposerPriority", "PubKey"}
+}
+
+func (vr *ValidatorsResponse) TableRows() [][]string {
+	rows := make([][]string, len(vr.Validators))
+	for i, v := range vr.Validators {
+		rows[i] = []string{v.Address, strconv.FormatInt(v.VotingPower, 10), strconv.FormatInt(v.ProposerPriority, 10), v.PubKey}
+	}
+	return rows
+}
+
+// StatusOpts are the options for the GetStatus function.
+type StatusOpts struct {
+	// AddressPrefix is the prefix the node's validator address is encoded with
+	AddressPrefix string
+	// SequencerURL is the URL of the sequencer to query
+	SequencerURL string
+}
+
+// StatusResponse is the response of the GetStatus function.
+type StatusResponse struct {
+	// Moniker is the name of the node
+	Moniker string `json:"moniker"`
+	NodeID  string `json:"nodeId"`
+	// Network is the chain ID of the sequencer
+	Network string `json:"network"`
+	// Version is the CometBFT version of the node
+	Version           string `json:"version"`
+	LatestBlockHeight int64  `json:"latestBlockHeight"`
+	// LatestBlockHash is the hex encoded hash of the latest block
+	LatestBlockHash string `json:"latestBlockHash"`
+	// LatestAppHash is the hex encoded app hash after the latest block
+	LatestAppHash   string `json:"latestAppHash"`
+	LatestBlockTime string `json:"latestBlockTime"`
+	// EarliestBlockHeight is the height of the earliest block the node has
+	EarliestBlockHeight int64 `json:"earliestBlockHeight"`
+	// CatchingUp is true if the node is still syncing blocks
+	CatchingUp bool `json:"catchingUp"`
+	// ValidatorAddress is the node's bech32m validator address, empty if the
+	// node is not a validator
+	ValidatorAddress string `json:"validatorAddress,omitempty"`
+	// VotingPower is the node's voting power
+	VotingPower int64 `json:"votingPower"`
+	// Validators is the number of validators in the validator set
+	Validators       int   `json:"validators"`
+	TotalVotingPower int64 `json:"totalVotingPower"`
+}
+
+func (sr *StatusResponse) JSON() ([]byte, error) {
+	return json.MarshalIndent(sr, "", "  ")
+}
+
+func (sr *StatusResponse) TableHeader() []string {
+	return []string{"Field", "Value"}
+}
+
+func (sr *StatusResponse) TableRows() [][]string {
+	rows := [][]string{
+		{"Moniker", sr.Moniker},
+		{"NodeID", sr.NodeID},
+		{"Network", sr.Network},
+		{"Version", sr.Version},
+		{"LatestBlockHeight", strconv.FormatInt(sr.LatestBlockHeight, 10)},
+		{"LatestBlockHash", sr.LatestBlockHash},
+		{"LatestAppHash", sr.LatestAppHash},
+		{"LatestBlockTime", sr.LatestBlockTime},
+		{"EarliestBlockHeight", strconv.FormatInt(sr.EarliestBlockHeight, 10)},
+		{"CatchingUp", strconv.FormatBool(sr.CatchingUp)},
+	}
+	if sr.ValidatorAddress != "" {
+		rows = append(rows,
+			[]string{"ValidatorAddress", sr.ValidatorAddress},
+			[]string{"VotingPower", strconv.FormatInt(sr.VotingPower, 10)},
+		)
+	}
+	return append(rows,
+		[]string{"Validators", strconv.Itoa(sr.Validators)},
+		[]string{"TotalVotingPower", strconv.FormatInt(sr.TotalVotingPower, 10)},
+	)
+}
+
+// AssetInfoOpts are the options for the GetAssetInfo function.
+type AssetInfoOpts struct {
+	// SequencerURL is the URL of the sequencer to resolve asset IDs with
+	SequencerURL string
+	// AssetCachePath is the path of the local cache of resolved asset IDs
+	AssetCachePath string
+	// Asset is a trace-prefixed denom, an IBC-prefixed denom or a hex
+	// encoded asset ID
+	Asset string
+}
+
+// AssetInfoResponse is the response of the GetAssetInfo function.
+type AssetInfoResponse struct {
+	// Denom is the trace-prefixed denom of the asset
+	Denom string `json:"denom"`
+	// ID is the hex encoded asset ID
+	ID string `json:"id"`
+	// IBCPrefixed is the IBC-prefixed denom of the asset
+	IBCPrefixed string `json:"ibcPrefixed"`
+	// Source is one of the AssetSource constants
+	Source string `json:"source"`
+}
+
+func (air *AssetInfoResponse) JSON() ([]byte, error) {
+	return json.MarshalIndent(air, "", "  ")
+}
+
+func (air *AssetInfoResponse) TableHeader() []string {
+	return []string{"Field", "Value"}
+}
+
+func (air *AssetInfoResponse) TableRows() [][]string {
+	return [][]string{
+		{"Denom", air.Denom},
+		{"ID", air.ID},
+		{"IBCPrefixed", air.IBCPrefixed},
+		{"Source", air.Source},
+	}
+}
+
+// SequencerBlockOpts are the options for the GetSequencerBlock function.
+type SequencerBlockOpts struct {
+	// SequencerGRPCURL is the URL of the sequencer's gRPC endpoint
+	SequencerGRPCURL string
+	// Height is the height of the block to get
+	Height uint64
+	// Rollups are the names or 0x prefixed hex IDs of the rollups to filter
+	// the block's transactions to. All transactions are returned if empty.
+	Rollups []string
+}
+
+// MerkleProof is a proof of inclusion of a leaf in a Merkle tree.
+type MerkleProof struct {
+	LeafIndex uint64 `json:"leafIndex"`
+	TreeSize  uint64 `json:"treeSize"`
+	// AuditPath are the hex encoded sibling hashes from the leaf to the root
+	AuditPath []string `json:"auditPath"`
+}
+
+// Summary returns the proof as space separated `key=value` pairs.
+func (mp *MerkleProof) Summary() string {
+	if mp == nil {
+		return ""
+	}
+	return fmt.Sprintf("leafIndex=%d treeSize=%d auditPath=%s", mp.LeafIndex, mp.TreeSize, strings.Join(mp.AuditPath, ","))
+}
+
+// RollupTx is a decoded rollup transaction within a sequencer block, either
+// sequenced data or a deposit.
+type RollupTx struct {
+	// Index is the index of the transaction within the rollup's transactions
+	Index int `json:"index"`
+	// Data is the hex encoded RollupData protobuf
+	Data string `json:"data"`
+	// Error is set if the transaction could not be decoded
+	Error string `json:"error,omitempty"`
+	DecodedAction
+}
+
+// SequencerBlockRollup holds the transactions of a single rollup within a
+// sequencer block.
+type SequencerBlockRollup struct {
+	// RollupID is the hex encoded rollup ID
+	RollupID string `json:"rollupId"`
+	// Proof is the proof of the rollup's transactions being included in the
+	// block's rollup transactions root
+	Proof        *MerkleProof `json:"proof"`
+	Transactions []*RollupTx  `json:"transactions"`
+}
+
+// SequencerBlockResponse is the response of the GetSequencerBlock function.
+type SequencerBlockResponse struct {
+	// Block is the block as returned by the sequencer. Unfiltered blocks are
+	// converted to a filtered block containing every rollup.
+	Block   *sequencerblockv1.FilteredSequencerBlock `json:"-"`
+	Height  uint64                                   `json:"height"`
+	ChainID string                                   `json:"chainId"`
+	Time    string                                   `json:"time"`
+	// BlockHash is the hex encoded hash of the CometBFT block
+	BlockHash string `json:"blockHash"`
+	// DataHash is the hex encoded Merkle root of the CometBFT block's
+	// transactions
+	DataHash        string `json:"dataHash"`
+	ProposerAddress string `json:"proposerAddress"`
+	// RollupTransactionsRoot is the hex encoded Merkle root of the rollup
+	// transactions of every rollup in the block
+	RollupTransactionsRoot string `json:"rollupTransactionsRoot"`
+	// RollupTransactionsProof proves the rollup transactions root is
+	// included in the data hash
+	RollupTransactionsProof *MerkleProof `json:"rollupTransactionsProof"`
+	// RollupIDsProof proves the root of RollupIDs is included in the data
+	// hash
+	RollupIDsProof *MerkleProof `json:"rollupIdsProof"`
+	// RollupIDs are the hex encoded IDs of every rollup in the block,
+	// including the ones filtered out of Rollups
+	RollupIDs []string                `json:"rollupIds"`
+	Rollups   []*SequencerBlockRollup `json:"rollups"`
+}
+
+func (sbr *SequencerBlockResponse) JSON() ([]byte, error) {
+	return json.MarshalIndent(sbr, "", "  ")
+}
+
+func (sbr *SequencerBlockResponse) TableHeader() []string {
+	return []string{"RollupID", "Index", "Type", "Details"}
+}
+
+func (sbr *SequencerBlockResponse) TableRows() [][]string {
+	rows := [][]string{
+		{"", "", "block", fmt.Sprintf("height=%d chainId=%s time=%s blockHash=%s rollupTransactionsRoot=%s", sbr.Height, sbr.ChainID, sbr.Time, sbr.BlockHash, sbr.RollupTransactionsRoot)},
+		{"", "", "rollup_transactions_proof", sbr.RollupTransactionsProof.Summary()},
+		{"", "", "rollup_ids_proof", sbr.RollupIDsProof.Summary()},
+	}
+
+	included := map[string]bool{}
+	for _, rollup := range sbr.Rollups {
+		included[rollup.RollupID] = true
+		rows = append(rows, []string{rollup.RollupID, "", "proof", rollup.Proof.Summary()})
+		for _, tx := range rollup.Transactions {
+			details := tx.Summary()
+			if tx.Error != "" {
+				details = tx.Error
+			}
+			rows = append(rows, []string{rollup.RollupID, strconv.Itoa(tx.Index), tx.Type, details})
+		}
+	}
+	// list the rollups whose transactions were filtered out, so the table
+	// shows every rollup in the block
+	for _, id := range sbr.RollupIDs {
+		if !included[id] {
+			rows = append(rows, []string{id, "", "filtered", ""})
+		}
+	}
+	return rows
+}
+
+// VerifyRollupTxsOpts are the options for the VerifyRollupTxs function.
+type VerifyRollupTxsOpts struct {
+	// SequencerGRPCURL is the URL of the sequencer's gRPC endpoint
+	SequencerGRPCURL string
+	// Height is the height of the block to verify
+	Height uint64
+	// Rollup is the name or 0x prefixed hex ID of the rollup to verify
+	Rollup string
+}
+
+// VerificationCheck is the outcome of a single verification step.
+type VerificationCheck struct {
+	Check  string `json:"check"`
+	Passed bool   `json:"passed"`
+	// Error describes the mismatch if the check failed
+	Error string `json:"error,omitempty"`
+}
+
+// VerifyRollupTxsResponse is the response of the VerifyRollupTxs function.
+type VerifyRollupTxsResponse struct {
+	Height uint64 `json:"height"`
+	// RollupID is the hex encoded ID of the verified rollup
+	RollupID string `json:"rollupId"`
+	// Transactions is the number of the rollup's transactions in the block
+	Transactions int `json:"transactions"`
+	// DataHash is the hex encoded data hash of the block the proofs were
+	// verified against
+	DataHash               string `json:"dataHash"`
+	RollupTransactionsRoot string `json:"rollupTransactionsRoot"`
+	// RollupIDsRoot is the hex encoded root recomputed from the block's
+	// rollup IDs
+	RollupIDsRoot string `json:"rollupIdsRoot"`
+	// Verified is true if every check passed
+	Verified bool                 `json:"verified"`
+	Checks   []*VerificationCheck `json:"checks"`
+}
+
+func (vr *VerifyRollupTxsResponse) JSON() ([]byte, error) {
+	return json.MarshalIndent(vr, "", "  ")
+}
+
+func (vr *VerifyRollupTxsResponse) TableHeader() []string {
+	return []string{"Check", "Result", "Details"}
+}
+
+func (vr *VerifyRollupTxsResponse) TableRows() [][]string {
+	rows := make([][]string, len(vr.Checks))
+	for i, check := range vr.Checks {
+		result := "ok"
+		if !check.Passed {
+			result = "MISMATCH"
+		}
+		rows[i] = []string{check.Check, result, check.Error}
+	}
+	return rows
+}
@@ -0,0 +1,55 @@
+package sequencer
+
+import (
+	"encoding/hex"
+
+	"github.com/astriaorg/astria-cli-go/modules/cli/internal/verify"
+	log "github.com/sirupsen/logrus"
+)
+
+// VerifyRollupTxs gets the sequencer block at opts.Height filtered to
+// opts.Rollup and verifies its commitments, independently of conductor. The
+// response lists the outcome of every check, and Verified is false if any
+// of them failed.
+func VerifyRollupTxs(opts VerifyRollupTxsOpts) (*VerifyRollupTxsResponse, error) {
+	block, err := GetSequencerBlock(SequencerBlockOpts{
+		SequencerGRPCURL: opts.SequencerGRPCURL,
+		Height:           opts.Height,
+		Rollups:          []string{opts.Rollup},
+	})
+	if err != nil {
+		return &VerifyRollupTxsResponse{}, err
+	}
+
+	rollupID := rollupIDFromNameOrHex(opts.Rollup).Inner
+	checks := verify.FilteredSequencerBlock(block.Block)
+	checks = append(checks, verify.RollupPresent(block.Block, rollupID))
+
+	resp := &VerifyRollupTxsResponse{
+		Height:                 block.Height,
+		RollupID:               hex.EncodeToString(rollupID),
+		DataHash:               block.DataHash,
+		RollupTransactionsRoot: block.RollupTransactionsRoot,
+		RollupIDsRoot:          hex.EncodeToString(verify.RollupIDsRoot(block.Block.GetAllRollupIds())),
+		Verified:               true,
+		Checks:                 make([]*VerificationCheck, 0, len(checks)),
+	}
+	for _, rollup := range block.Rollups {
+		if rollup.RollupID == resp.RollupID {
+			resp.Transactions = len(rollup.Transactions)
+		}
+	}
+	for _, check := range checks {
+		vc := &VerificationCheck{
+			Check:  check.Name,
+			Passed: check.Passed(),
+		}
+		if !check.Passed() {
+			vc.Error = check.Err.Error()
+			resp.Verified = false
+			log.WithError(check.Err).Debug("Verification check failed: ", check.Name)
+		}
+		resp.Checks = append(resp.Checks, vc)
+	}
+	return resp, nil
+}
@@ -0,0 +1,43 @@
+package sequencer
+
+import (
+	"testing"
+
+	sequencerblockv1 "github.com/astriaorg/astria-cli-go/modules/go-sequencer-client/proto/astria/sequencerblock/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyRollupTxs(t *testing.T) {
+	url := newTestSequencerGRPCServer(t, nil)
+
+	resp, err := VerifyRollupTxs(VerifyRollupTxsOpts{SequencerGRPCURL: url, Height: 7, Rollup: "other-rollup"})
+	require.NoError(t, err)
+	assert.True(t, resp.Verified)
+	assert.Equal(t, rollupIDString(rollupIdFromText("other-rollup")), resp.RollupID)
+	assert.Equal(t, 1, resp.Transactions)
+	for _, row := range resp.TableRows() {
+		assert.Equal(t, "ok", row[1], row[0])
+	}
+}
+
+func TestVerifyRollupTxsMismatch(t *testing.T) {
+	url := newTestSequencerGRPCServer(t, func(b *sequencerblockv1.FilteredSequencerBlock) {
+		// drop the rollup's transactions but keep it listed
+		b.RollupTransactions = nil
+	})
+
+	resp, err := VerifyRollupTxs(VerifyRollupTxsOpts{SequencerGRPCURL: url, Height: 7, Rollup: "test-rollup"})
+	require.NoError(t, err)
+	assert.False(t, resp.Verified)
+	assert.Equal(t, 0, resp.Transactions)
+
+	failed := []*VerificationCheck{}
+	for _, check := range resp.Checks {
+		if !check.Passed {
+			failed = append(failed, check)
+		}
+	}
+	require.Len(t, failed, 1)
+	assert.Contains(t, failed[0].Error, "transactions are missing")
+}
@@ -0,0 +1,94 @@
+package sequencer
+
+import (
+	"context"
+	"encoding/hex"
+	"time"
+
+	"github.com/astriaorg/astria-cli-go/modules/bech32m"
+	"github.com/astriaorg/astria-cli-go/modules/go-sequencer-client/client"
+	log "github.com/sirupsen/logrus"
+)
+
+// WatchBlocks streams new blocks from the sequencer, calling handle for each
+// one until ctx is done.
+func WatchBlocks(ctx context.Context, opts WatchOpts, handle func(*WatchBlockResponse)) error {
+	log.Debug("Creating CometBFT client with url: ", opts.SequencerURL)
+
+	c, err := client.NewClient(opts.SequencerURL)
+	if err != nil {
+		log.WithError(err).Error("Error creating sequencer client")
+		return err
+	}
+
+	blocks, err := c.SubscribeBlocks(ctx)
+	if err != nil {
+		log.WithError(err).Error("Error subscribing to blocks")
+		return err
+	}
+
+	for event := range blocks {
+		block := event.Block
+		handle(&WatchBlockResponse{
+			Height:   block.Height,
+			Time:     block.Time.UTC().Format(time.RFC3339Nano),
+			Hash:     hex.EncodeToString(event.BlockID.Hash),
+			Proposer: hex.EncodeToString(block.ProposerAddress),
+			NumTxs:   len(block.Txs),
+		})
+	}
+	return nil
+}
+
+// WatchTxs streams executed transactions from the sequencer, calling handle
+// for each one matching the address and action type filters until ctx is
+// done.
+func WatchTxs(ctx context.Context, opts WatchOpts, handle func(*WatchTxResponse)) error {
+	log.Debug("Creating CometBFT client with url: ", opts.SequencerURL)
+
+	filter := client.TxFilter{
+		ActionType: opts.ActionType,
+	}
+	if opts.Address != "" {
+		_, signer, err := bech32m.DecodeFromString(opts.Address)
+		if err != nil {
+			log.WithError(err).Error("Error decoding address")
+			return err
+		}
+		filter.Address = opts.Address
+		filter.Signer = signer[:]
+	}
+
+	c, err := client.NewClient(opts.SequencerURL)
+	if err != nil {
+		log.WithError(err).Error("Error creating sequencer client")
+		return err
+	}
+
+	txs, err := c.SubscribeTxs(ctx, filter)
+	if err != nil {
+		log.WithError(err).Error("Error subscribing to transactions")
+		return err
+	}
+
+	for event := range txs {
+		res := &WatchTxResponse{
+			Height: event.Height,
+			TxHash: hex.EncodeToString(event.Hash),
+			Code:   event.Result.Code,
+			Log:    event.Result.Log,
+		}
+		if event.Transaction != nil {
+			decoded, err := DecodeTx(event.Transaction, opts.AddressPrefix)
+			if err != nil {
+				log.WithError(err).Debug("Error decoding transaction")
+			} else {
+				res.From = decoded.From
+				res.Nonce = decoded.Nonce
+				res.Actions = decoded.Actions
+			}
+		}
+		handle(res)
+	}
+	return nil
+}
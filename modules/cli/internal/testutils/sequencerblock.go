@@ -0,0 +1,78 @@
+package testutils
+
+import (
+	"crypto/sha256"
+
+	primproto "buf.build/gen/go/astria/primitives/protocolbuffers/go/astria/primitive/v1"
+	sequencerblockv1 "github.com/astriaorg/astria-cli-go/modules/go-sequencer-client/proto/astria/sequencerblock/v1"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// RollupTxs are the transactions of a rollup in a test sequencer block.
+type RollupTxs struct {
+	ID  []byte
+	Txs [][]byte
+}
+
+// NewSequencerBlock returns a sequencer block with valid commitments to the
+// transactions of rollups, which must be sorted by ID. The Merkle trees are
+// built with CometBFT's implementation, independently of the code under test.
+func NewSequencerBlock(height uint64, rollups []RollupTxs) *sequencerblockv1.SequencerBlock {
+	ids := make([][]byte, 0, len(rollups))
+	leaves := make([][]byte, 0, len(rollups))
+	for _, rollup := range rollups {
+		ids = append(ids, rollup.ID)
+		leaves = append(leaves, append(append([]byte{}, rollup.ID...), merkleRoot(rollup.Txs)...))
+	}
+	txsRoot := merkleRoot(leaves)
+	_, leafProofs := merkle.ProofsFromByteSlices(leaves)
+
+	// the rollup roots are the first two transactions of the CometBFT block
+	cometTxs := cmttypes.Txs{txsRoot, merkleRoot(ids), []byte("signed transaction")}
+
+	rollupTxs := make([]*sequencerblockv1.RollupTransactions, 0, len(rollups))
+	for i, rollup := range rollups {
+		rollupTxs = append(rollupTxs, &sequencerblockv1.RollupTransactions{
+			RollupId:     &primproto.RollupId{Inner: rollup.ID},
+			Transactions: rollup.Txs,
+			Proof:        protoProof(*leafProofs[i]),
+		})
+	}
+
+	return &sequencerblockv1.SequencerBlock{
+		Header: &sequencerblockv1.SequencerBlockHeader{
+			ChainId:                "test-chain",
+			Height:                 height,
+			Time:                   timestamppb.Now(),
+			DataHash:               cometTxs.Hash(),
+			RollupTransactionsRoot: txsRoot,
+		},
+		RollupTransactions:      rollupTxs,
+		RollupTransactionsProof: protoProof(cometTxs.Proof(0).Proof),
+		RollupIdsProof:          protoProof(cometTxs.Proof(1).Proof),
+		BlockHash:               make([]byte, sha256.Size),
+	}
+}
+
+// merkleRoot returns the Merkle root of leaves, which is all zeros for an
+// empty tree.
+func merkleRoot(leaves [][]byte) []byte {
+	if len(leaves) == 0 {
+		return make([]byte, sha256.Size)
+	}
+	return merkle.HashFromByteSlices(leaves)
+}
+
+func protoProof(proof merkle.Proof) *primproto.Proof {
+	auditPath := []byte{}
+	for _, aunt := range proof.Aunts {
+		auditPath = append(auditPath, aunt...)
+	}
+	return &primproto.Proof{
+		AuditPath: auditPath,
+		LeafIndex: uint64(proof.Index),
+		TreeSize:  uint64(proof.Total),
+	}
+}
@@ -1,6 +1,9 @@
 package ui
 
 import (
+	"encoding/csv"
+	"strings"
+
 	"github.com/pterm/pterm"
 	log "github.com/sirupsen/logrus"
 )
@@ -16,9 +19,12 @@ type Printable interface {
 type ResultsPrinter struct {
 	Data      Printable
 	PrintJSON bool
+	PrintCSV  bool
 }
 
-// Render executes the appropriate rendering method based on the PrintJSON flag.
+// Render executes the appropriate rendering method based on the PrintJSON and
+// PrintCSV flags. JSON takes precedence over CSV, and a table is rendered if
+// neither is set.
 func (rp *ResultsPrinter) Render() {
 	if rp.PrintJSON {
 		jsonData, err := rp.Data.JSON()
@@ -27,6 +33,18 @@ func (rp *ResultsPrinter) Render() {
 			return
 		}
 		pterm.Println(string(jsonData))
+	} else if rp.PrintCSV {
+		var output strings.Builder
+		w := csv.NewWriter(&output)
+		if err := w.Write(rp.Data.TableHeader()); err != nil {
+			log.WithError(err).Error("Error writing CSV")
+			return
+		}
+		if err := w.WriteAll(rp.Data.TableRows()); err != nil {
+			log.WithError(err).Error("Error writing CSV")
+			return
+		}
+		pterm.Print(output.String())
 	} else {
 		header := rp.Data.TableHeader()
 		rows := rp.Data.TableRows()
@@ -0,0 +1,64 @@
+package ui
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/pterm/pterm"
+	log "github.com/sirupsen/logrus"
+)
+
+// StreamPrinter handles printing of Printable data as it arrives. Tables are
+// printed a row at a time beneath a header that is printed once, and JSON is
+// printed as newline delimited JSON.
+type StreamPrinter struct {
+	PrintJSON bool
+
+	widths []int
+}
+
+// Print renders a single Printable value.
+func (sp *StreamPrinter) Print(data Printable) {
+	if sp.PrintJSON {
+		jsonData, err := data.JSON()
+		if err != nil {
+			log.WithError(err).Error("Error marshalling to JSON")
+			return
+		}
+		var compact bytes.Buffer
+		if err := json.Compact(&compact, jsonData); err != nil {
+			log.WithError(err).Error("Error compacting JSON")
+			return
+		}
+		pterm.Println(compact.String())
+		return
+	}
+
+	if sp.widths == nil {
+		header := data.TableHeader()
+		sp.widths = make([]int, len(header))
+		pterm.Println(pterm.ThemeDefault.TableHeaderStyle.Sprint(sp.formatRow(header)))
+	}
+	for _, row := range data.TableRows() {
+		pterm.Println(sp.formatRow(row))
+	}
+}
+
+// formatRow pads each cell to the widest value seen in its column so far.
+func (sp *StreamPrinter) formatRow(row []string) string {
+	cells := make([]string, len(row))
+	for i, cell := range row {
+		if i < len(sp.widths) {
+			if len(cell) > sp.widths[i] {
+				sp.widths[i] = len(cell)
+			}
+			// the last column is not padded to avoid trailing whitespace
+			if i < len(row)-1 {
+				cell += strings.Repeat(" ", sp.widths[i]-len(cell))
+			}
+		}
+		cells[i] = cell
+	}
+	return strings.Join(cells, " ")
+}
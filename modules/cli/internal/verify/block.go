@@ -0,0 +1,138 @@
+package verify
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	primproto "buf.build/gen/go/astria/primitives/protocolbuffers/go/astria/primitive/v1"
+	sequencerblockv1 "github.com/astriaorg/astria-cli-go/modules/go-sequencer-client/proto/astria/sequencerblock/v1"
+)
+
+// Check is the outcome of a single verification step. Err is nil if the
+// check passed.
+type Check struct {
+	Name string
+	Err  error
+}
+
+// Passed reports whether the check passed.
+func (c Check) Passed() bool {
+	return c.Err == nil
+}
+
+// RollupTransactionsLeaf returns the leaf of a rollup in the rollup
+// transactions tree, the rollup ID followed by the Merkle root of its
+// transactions.
+func RollupTransactionsLeaf(rollupID []byte, txs [][]byte) []byte {
+	return append(append([]byte{}, rollupID...), MerkleRoot(txs)...)
+}
+
+// RollupIDsRoot returns the Merkle root of the rollup IDs tree.
+func RollupIDsRoot(ids []*primproto.RollupId) []byte {
+	leaves := make([][]byte, 0, len(ids))
+	for _, id := range ids {
+		leaves = append(leaves, id.GetInner())
+	}
+	return MerkleRoot(leaves)
+}
+
+// FilteredSequencerBlock verifies the commitments of a filtered sequencer
+// block against its header's data hash:
+//   - the rollup transactions root is included in the data hash
+//   - the root recomputed from the rollup IDs is included in the data hash,
+//     so no rollup was left out of the list
+//   - the rollup IDs are in ascending order without duplicates
+//   - the root recomputed from each rollup's transactions is included in the
+//     rollup transactions root, so none were dropped, altered or reordered
+//   - each rollup with transactions is listed in the rollup IDs
+func FilteredSequencerBlock(block *sequencerblockv1.FilteredSequencerBlock) []Check {
+	header := block.GetHeader()
+	dataHash := header.GetDataHash()
+	txsRoot := header.GetRollupTransactionsRoot()
+
+	// CometBFT hashes each transaction before building the data hash tree,
+	// so the leaves are the hashes of the roots.
+	txsRootHash := sha256.Sum256(txsRoot)
+	idsRoot := RollupIDsRoot(block.GetAllRollupIds())
+	idsRootHash := sha256.Sum256(idsRoot)
+	checks := []Check{
+		{
+			Name: "rollup transactions root included in data hash",
+			Err:  VerifyProof(txsRootHash[:], block.GetRollupTransactionsProof(), dataHash),
+		},
+		{
+			Name: "rollup IDs root " + hex.EncodeToString(idsRoot) + " included in data hash",
+			Err:  VerifyProof(idsRootHash[:], block.GetRollupIdsProof(), dataHash),
+		},
+		{
+			Name: "rollup IDs sorted and unique",
+			Err:  rollupIDsSorted(block.GetAllRollupIds()),
+		},
+	}
+
+	for _, rollupTxs := range block.GetRollupTransactions() {
+		id := rollupTxs.GetRollupId().GetInner()
+		name := "rollup " + hex.EncodeToString(id)
+
+		leaf := RollupTransactionsLeaf(id, rollupTxs.GetTransactions())
+		checks = append(checks, Check{
+			Name: fmt.Sprintf("%s transactions (%d) included in rollup transactions root", name, len(rollupTxs.GetTransactions())),
+			Err:  VerifyProof(leaf, rollupTxs.GetProof(), txsRoot),
+		})
+
+		var listed error
+		if !containsRollupID(block.GetAllRollupIds(), id) {
+			listed = errors.New("rollup is missing from the rollup IDs")
+		}
+		checks = append(checks, Check{
+			Name: name + " listed in rollup IDs",
+			Err:  listed,
+		})
+	}
+	return checks
+}
+
+// RollupPresent checks that a rollup's transactions are in a filtered block
+// if and only if the rollup is listed in the block's rollup IDs, ie. that
+// the transactions of a rollup in the block were not withheld.
+func RollupPresent(block *sequencerblockv1.FilteredSequencerBlock, rollupID []byte) Check {
+	listed := containsRollupID(block.GetAllRollupIds(), rollupID)
+	included := false
+	for _, rollupTxs := range block.GetRollupTransactions() {
+		if bytes.Equal(rollupTxs.GetRollupId().GetInner(), rollupID) {
+			included = true
+		}
+	}
+
+	check := Check{Name: "rollup " + hex.EncodeToString(rollupID) + " transactions returned"}
+	switch {
+	case listed && !included:
+		check.Err = errors.New("rollup is listed in the rollup IDs but its transactions are missing")
+	case !listed && included:
+		check.Err = errors.New("transactions were returned for a rollup that is not in the rollup IDs")
+	}
+	return check
+}
+
+// rollupIDsSorted returns an error if the rollup IDs are not in strictly
+// ascending order, as the sequencer commits to them.
+func rollupIDsSorted(ids []*primproto.RollupId) error {
+	for i := 1; i < len(ids); i++ {
+		if bytes.Compare(ids[i-1].GetInner(), ids[i].GetInner()) >= 0 {
+			return fmt.Errorf("rollup ID %s at index %d is not greater than the previous ID %s", hex.EncodeToString(ids[i].GetInner()), i, hex.EncodeToString(ids[i-1].GetInner()))
+		}
+	}
+	return nil
+}
+
+func containsRollupID(ids []*primproto.RollupId, id []byte) bool {
+	for _, other := range ids {
+		if bytes.Equal(other.GetInner(), id) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,107 @@
+package verify
+
+import (
+	"bytes"
+	"testing"
+
+	primproto "buf.build/gen/go/astria/primitives/protocolbuffers/go/astria/primitive/v1"
+	"github.com/astriaorg/astria-cli-go/modules/cli/internal/testutils"
+	sequencerblockv1 "github.com/astriaorg/astria-cli-go/modules/go-sequencer-client/proto/astria/sequencerblock/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testRollupA = bytes.Repeat([]byte{0x0a}, 32)
+	testRollupB = bytes.Repeat([]byte{0x0b}, 32)
+)
+
+// testFilteredBlock returns a block with transactions for two rollups,
+// filtered to the transactions of rollup B.
+func testFilteredBlock() *sequencerblockv1.FilteredSequencerBlock {
+	block := testutils.NewSequencerBlock(7, []testutils.RollupTxs{
+		{ID: testRollupA, Txs: [][]byte{[]byte("a1")}},
+		{ID: testRollupB, Txs: [][]byte{[]byte("b1"), []byte("b2"), []byte("b3")}},
+	})
+	return &sequencerblockv1.FilteredSequencerBlock{
+		BlockHash:               block.BlockHash,
+		Header:                  block.Header,
+		RollupTransactions:      block.RollupTransactions[1:],
+		RollupTransactionsProof: block.RollupTransactionsProof,
+		AllRollupIds:            []*primproto.RollupId{{Inner: testRollupA}, {Inner: testRollupB}},
+		RollupIdsProof:          block.RollupIdsProof,
+	}
+}
+
+func failedChecks(checks []Check) []string {
+	failed := []string{}
+	for _, check := range checks {
+		if !check.Passed() {
+			failed = append(failed, check.Name)
+		}
+	}
+	return failed
+}
+
+func TestFilteredSequencerBlock(t *testing.T) {
+	block := testFilteredBlock()
+	checks := FilteredSequencerBlock(block)
+	assert.Len(t, checks, 5)
+	assert.Empty(t, failedChecks(checks))
+	assert.True(t, RollupPresent(block, testRollupB).Passed())
+	assert.True(t, RollupPresent(block, bytes.Repeat([]byte{0x0c}, 32)).Passed(), "a rollup absent from the block should pass")
+}
+
+func TestFilteredSequencerBlockEmpty(t *testing.T) {
+	block := testutils.NewSequencerBlock(7, nil)
+	checks := FilteredSequencerBlock(&sequencerblockv1.FilteredSequencerBlock{
+		Header:                  block.Header,
+		RollupTransactionsProof: block.RollupTransactionsProof,
+		RollupIdsProof:          block.RollupIdsProof,
+	})
+	assert.Empty(t, failedChecks(checks))
+}
+
+func TestFilteredSequencerBlockMismatch(t *testing.T) {
+	tests := []struct {
+		name   string
+		tamper func(*sequencerblockv1.FilteredSequencerBlock)
+		failed int
+	}{
+		{"dropped transaction", func(b *sequencerblockv1.FilteredSequencerBlock) {
+			b.RollupTransactions[0].Transactions = b.RollupTransactions[0].Transactions[:2]
+		}, 1},
+		{"reordered transactions", func(b *sequencerblockv1.FilteredSequencerBlock) {
+			txs := b.RollupTransactions[0].Transactions
+			txs[0], txs[1] = txs[1], txs[0]
+		}, 1},
+		{"altered transaction", func(b *sequencerblockv1.FilteredSequencerBlock) {
+			b.RollupTransactions[0].Transactions[2] = []byte("b4")
+		}, 1},
+		{"dropped rollup ID", func(b *sequencerblockv1.FilteredSequencerBlock) {
+			b.AllRollupIds = b.AllRollupIds[1:]
+		}, 1},
+		{"reordered rollup IDs", func(b *sequencerblockv1.FilteredSequencerBlock) {
+			b.AllRollupIds[0], b.AllRollupIds[1] = b.AllRollupIds[1], b.AllRollupIds[0]
+		}, 2},
+		{"unlisted rollup", func(b *sequencerblockv1.FilteredSequencerBlock) {
+			b.AllRollupIds = b.AllRollupIds[:1]
+		}, 2},
+		{"wrong data hash", func(b *sequencerblockv1.FilteredSequencerBlock) {
+			b.Header.DataHash = make([]byte, 32)
+		}, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			block := testFilteredBlock()
+			tt.tamper(block)
+			assert.Len(t, failedChecks(FilteredSequencerBlock(block)), tt.failed)
+		})
+	}
+}
+
+func TestRollupPresentWithheld(t *testing.T) {
+	block := testFilteredBlock()
+	check := RollupPresent(block, testRollupA)
+	require.Error(t, check.Err, "transactions of a listed rollup should be returned")
+}
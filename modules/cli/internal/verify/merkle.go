@@ -0,0 +1,111 @@
+// Package verify independently checks the commitments of sequencer blocks,
+// as conductor does before executing rollup data.
+//
+// Sequencer blocks commit to their rollup data with RFC 6962 Merkle trees.
+// The first two transactions of every CometBFT block are the root of the
+// rollup transactions tree, with a leaf per rollup, and the root of the
+// rollup IDs tree. Both are included in the block's data hash, and each
+// rollup's leaf commits to the root of the tree of its transactions.
+package verify
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	primproto "buf.build/gen/go/astria/primitives/protocolbuffers/go/astria/primitive/v1"
+)
+
+const (
+	// leafPrefix and nodePrefix separate leaf from inner node hashes, as
+	// specified by RFC 6962.
+	leafPrefix = 0x00
+	nodePrefix = 0x01
+)
+
+// leafHash returns the hash of a Merkle tree leaf.
+func leafHash(leaf []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{leafPrefix})
+	h.Write(leaf)
+	return h.Sum(nil)
+}
+
+// nodeHash returns the hash of an inner Merkle tree node.
+func nodeHash(left, right []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{nodePrefix})
+	h.Write(left)
+	h.Write(right)
+	return h.Sum(nil)
+}
+
+// MerkleRoot returns the RFC 6962 Merkle tree hash of leaves. The root of an
+// empty tree is all zeros, as computed by the sequencer.
+func MerkleRoot(leaves [][]byte) []byte {
+	if len(leaves) == 0 {
+		return make([]byte, sha256.Size)
+	}
+	return subtreeRoot(leaves)
+}
+
+func subtreeRoot(leaves [][]byte) []byte {
+	if len(leaves) == 1 {
+		return leafHash(leaves[0])
+	}
+	// split at the largest power of two smaller than the number of leaves
+	split := 1
+	for split*2 < len(leaves) {
+		split *= 2
+	}
+	return nodeHash(subtreeRoot(leaves[:split]), subtreeRoot(leaves[split:]))
+}
+
+// VerifyProof checks that proof proves the inclusion of leaf in the Merkle
+// tree with the given root, following RFC 9162 section 2.1.3.2.
+func VerifyProof(leaf []byte, proof *primproto.Proof, root []byte) error {
+	if proof == nil {
+		return errors.New("proof is missing")
+	}
+	path := proof.GetAuditPath()
+	if len(path)%sha256.Size != 0 {
+		return fmt.Errorf("audit path length %d is not a multiple of %d", len(path), sha256.Size)
+	}
+	if proof.GetTreeSize() == 0 || proof.GetLeafIndex() >= proof.GetTreeSize() {
+		return fmt.Errorf("leaf index %d is out of range for tree size %d", proof.GetLeafIndex(), proof.GetTreeSize())
+	}
+
+	index := proof.GetLeafIndex()
+	last := proof.GetTreeSize() - 1
+	hash := leafHash(leaf)
+	for i := 0; i < len(path); i += sha256.Size {
+		if last == 0 {
+			return errors.New("audit path is longer than the tree is deep")
+		}
+		sibling := path[i : i+sha256.Size]
+		if index%2 == 1 || index == last {
+			hash = nodeHash(sibling, hash)
+			if index%2 == 0 {
+				// the node is the last of its level and has no right
+				// sibling, so it moves up without being hashed
+				for index%2 == 0 && index != 0 {
+					index >>= 1
+					last >>= 1
+				}
+			}
+		} else {
+			hash = nodeHash(hash, sibling)
+		}
+		index >>= 1
+		last >>= 1
+	}
+	if last != 0 {
+		return errors.New("audit path is shorter than the tree is deep")
+	}
+	if !bytes.Equal(hash, root) {
+		return fmt.Errorf("proof reconstructs root %s, expected %s", hex.EncodeToString(hash), hex.EncodeToString(root))
+	}
+	return nil
+}
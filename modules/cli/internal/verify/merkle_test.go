@@ -0,0 +1,88 @@
+package verify
+
+import (
+	"fmt"
+	"testing"
+
+	primproto "buf.build/gen/go/astria/primitives/protocolbuffers/go/astria/primitive/v1"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testLeaves(n int) [][]byte {
+	leaves := make([][]byte, n)
+	for i := range leaves {
+		leaves[i] = []byte(fmt.Sprintf("leaf %d", i))
+	}
+	return leaves
+}
+
+// cometProof converts a CometBFT Merkle proof, which uses the same RFC 6962
+// tree, into a Proof protobuf.
+func cometProof(proof *merkle.Proof) *primproto.Proof {
+	auditPath := []byte{}
+	for _, aunt := range proof.Aunts {
+		auditPath = append(auditPath, aunt...)
+	}
+	return &primproto.Proof{
+		AuditPath: auditPath,
+		LeafIndex: uint64(proof.Index),
+		TreeSize:  uint64(proof.Total),
+	}
+}
+
+func TestMerkleRoot(t *testing.T) {
+	assert.Equal(t, make([]byte, 32), MerkleRoot(nil), "empty tree root should be all zeros")
+	for n := 1; n <= 17; n++ {
+		leaves := testLeaves(n)
+		assert.Equal(t, merkle.HashFromByteSlices(leaves), MerkleRoot(leaves), "tree with %d leaves", n)
+	}
+}
+
+func TestVerifyProof(t *testing.T) {
+	for n := 1; n <= 17; n++ {
+		leaves := testLeaves(n)
+		root, proofs := merkle.ProofsFromByteSlices(leaves)
+		for i, proof := range proofs {
+			assert.NoError(t, VerifyProof(leaves[i], cometProof(proof), root), "leaf %d of %d", i, n)
+		}
+	}
+}
+
+func TestVerifyProofInvalid(t *testing.T) {
+	leaves := testLeaves(5)
+	root, proofs := merkle.ProofsFromByteSlices(leaves)
+	valid := cometProof(proofs[2])
+	require.NoError(t, VerifyProof(leaves[2], valid, root))
+
+	tamperedPath := cometProof(proofs[2])
+	tamperedPath.AuditPath[0] ^= 0xff
+	wrongIndex := cometProof(proofs[2])
+	wrongIndex.LeafIndex = 3
+	wrongSize := cometProof(proofs[2])
+	wrongSize.TreeSize = 9
+	truncated := cometProof(proofs[2])
+	truncated.AuditPath = truncated.AuditPath[:32]
+	outOfRange := cometProof(proofs[2])
+	outOfRange.LeafIndex = 5
+
+	tests := []struct {
+		name  string
+		leaf  []byte
+		proof *primproto.Proof
+	}{
+		{"wrong leaf", leaves[3], valid},
+		{"tampered audit path", leaves[2], tamperedPath},
+		{"wrong index", leaves[2], wrongIndex},
+		{"wrong tree size", leaves[2], wrongSize},
+		{"truncated audit path", leaves[2], truncated},
+		{"index out of range", leaves[2], outOfRange},
+		{"missing proof", leaves[2], nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Error(t, VerifyProof(tt.leaf, tt.proof, root))
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/cometbft/cometbft/rpc/client"
 	"github.com/cometbft/cometbft/rpc/client/http"
 	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/protobuf/proto"
 )
@@ -27,6 +28,7 @@ type BalanceResponse struct {
 
 // Client is an HTTP tendermint client.
 type Client struct {
+	// websocket is the address used for event subscriptions
 	websocket string
 	client    *http.HTTP
 }
@@ -42,23 +44,32 @@ func NewClient(url string) (*Client, error) {
 
 	// Replace and print results
 	websocket := re.ReplaceAllString(url, "")
-	websocket = "tcp://" + websocket
+	if strings.HasPrefix(url, "https://") {
+		websocket = "wss://" + websocket
+	} else {
+		websocket = "tcp://" + websocket
+	}
 	return &Client{
 		websocket: websocket,
 		client:    c,
 	}, nil
 }
 
-// BroadcastTx broadcasts a transaction. If async is true, the function will
-// return immediately. The response seen is the generated data used for
-// submitting the transaction. It does not confirm that the data has been
-// included on chain. If async is false, the function will wait for the
-// transaction to be seen on the network.
-func (c *Client) BroadcastTx(ctx context.Context, tx *txproto.Transaction, async bool) (*coretypes.ResultBroadcastTx, error) {
-	if async {
+// BroadcastTx broadcasts a transaction and waits for it as selected by
+// opts.Mode. With WaitNone the function returns immediately and the response
+// does not confirm that the transaction is valid. With WaitSync it returns
+// once the transaction passed CheckTx, and with WaitCommit once the
+// transaction was included in a block and opts.Confirmations further blocks
+// were committed.
+func (c *Client) BroadcastTx(ctx context.Context, tx *txproto.Transaction, opts WaitOptions) (*coretypes.ResultBroadcastTx, error) {
+	switch opts.Mode {
+	case WaitNone:
 		return c.BroadcastTxAsync(ctx, tx)
+	case WaitSync:
+		return c.broadcastTxCheck(ctx, tx)
+	default:
+		return c.BroadcastTxSync(ctx, tx, opts)
 	}
-	return c.BroadcastTxSync(ctx, tx)
 }
 
 // BroadcastTxAsync broadcasts a transaction and returns immediately.
@@ -72,8 +83,22 @@ func (c *Client) BroadcastTxAsync(ctx context.Context, tx *txproto.Transaction)
 }
 
 // BroadcastTxSync broadcasts a transaction and waits for the response that
-// confirms the transaction was included.
-func (c *Client) BroadcastTxSync(ctx context.Context, tx *txproto.Transaction) (*coretypes.ResultBroadcastTx, error) {
+// confirms the transaction was included, as configured by opts.
+func (c *Client) BroadcastTxSync(ctx context.Context, tx *txproto.Transaction, opts WaitOptions) (*coretypes.ResultBroadcastTx, error) {
+	result, err := c.broadcastTxCheck(ctx, tx)
+	if err != nil {
+		return result, err
+	}
+
+	if _, err := c.WaitForTx(ctx, result.Hash, opts); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+// broadcastTxCheck broadcasts a transaction and returns once it passed
+// CheckTx.
+func (c *Client) broadcastTxCheck(ctx context.Context, tx *txproto.Transaction) (*coretypes.ResultBroadcastTx, error) {
 	bytes, err := proto.Marshal(tx)
 	if err != nil {
 		return nil, err
@@ -86,30 +111,83 @@ func (c *Client) BroadcastTxSync(ctx context.Context, tx *txproto.Transaction) (
 	if result.Code != 0 {
 		return result, errors.New(result.Log)
 	}
+	return result, nil
+}
 
-	// wait for the tx to be included in a block
-	retryCount := 50
-	retryInterval := 250 * time.Millisecond
-	for i := 0; i < retryCount; i++ {
-		t, err := c.client.Tx(ctx, result.Hash, true)
-		if err != nil {
-			// manually ignore error that says tx not found
-			if strings.HasPrefix(err.Error(), "error in json rpc client, with http response metadata: (Status: 200 OK, Protocol HTTP/1.1). RPC error -32603") {
+// ErrTxFailed is returned, wrapped, when a transaction was included in a
+// block but failed to execute.
+var ErrTxFailed = errors.New("tx failed")
+
+// WaitForTx polls for the transaction with the given hash until it is
+// included in a block and opts.Confirmations further blocks were committed,
+// or until opts.Timeout elapses. If the transaction failed to execute, it is
+// returned along with an error wrapping ErrTxFailed.
+func (c *Client) WaitForTx(ctx context.Context, hash []byte, opts WaitOptions) (*coretypes.ResultTx, error) {
+	opts = opts.withDefaults()
+	ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(opts.PollInterval)
+	defer ticker.Stop()
+
+	var included *coretypes.ResultTx
+	for {
+		if included == nil {
+			t, err := c.client.Tx(ctx, hash, false)
+			switch {
+			case err == nil && t.TxResult.Code != 0:
+				return t, fmt.Errorf("%w: tx %X at height %d with code %d: %s", ErrTxFailed, hash, t.Height, t.TxResult.Code, t.TxResult.Log)
+			case err == nil:
+				log.Debugf("tx included at height %d", t.Height)
+				included = t
+			case isTxNotFound(err):
 				log.Debug("tx not found, retrying...")
-				// wait a short amount of time between retries
-				time.Sleep(retryInterval)
-				continue
-			} else {
-				return result, err
+			case ctx.Err() != nil:
+			default:
+				return nil, err
 			}
+		}
 
+		if included != nil {
+			if opts.Confirmations <= 0 {
+				return included, nil
+			}
+			height, err := c.GetBlockHeight(ctx)
+			if err != nil && ctx.Err() == nil {
+				return nil, err
+			}
+			if err == nil && height-included.Height >= opts.Confirmations {
+				return included, nil
+			}
+			log.Debugf("waiting for %d confirmations, latest height %d", opts.Confirmations, height)
 		}
-		if t != nil {
-			return result, nil
+
+		select {
+		case <-ctx.Done():
+			if included != nil {
+				return included, fmt.Errorf("tx %X included at height %d but not confirmed by %d blocks after %s", hash, included.Height, opts.Confirmations, opts.Timeout)
+			}
+			return nil, fmt.Errorf("tx %X not found after %s", hash, opts.Timeout)
+		case <-ticker.C:
 		}
 	}
+}
+
+// isTxNotFound returns true if err is the error CometBFT returns for a
+// transaction that has not been included in a block.
+func isTxNotFound(err error) bool {
+	var rpcErr *rpctypes.RPCError
+	if !errors.As(err, &rpcErr) {
+		return false
+	}
+	return rpcErr.Code == -32603 && strings.Contains(rpcErr.Data, "not found")
+}
 
-	return result, fmt.Errorf("tx %s not found after %d retries", result.Hash, retryCount)
+// GetTx returns the transaction with the given hash along with its inclusion
+// height and execution result. It returns an error if the transaction has not
+// been included in a block.
+func (c *Client) GetTx(ctx context.Context, hash []byte) (*coretypes.ResultTx, error) {
+	return c.client.Tx(ctx, hash, false)
 }
 
 func (c *Client) GetBalances(ctx context.Context, addr string) ([]*BalanceResponse, error) {
@@ -176,6 +254,40 @@ func (c *Client) GetBlockHeight(ctx context.Context) (int64, error) {
 	return block.Block.Height, nil
 }
 
+// validatorsPerPage is the number of validators requested per page when
+// retrieving the validator set.
+const validatorsPerPage = 100
+
+// GetValidators returns the complete validator set at the given height, or
+// at the latest height if height is nil.
+func (c *Client) GetValidators(ctx context.Context, height *int64) (*coretypes.ResultValidators, error) {
+	perPage := validatorsPerPage
+	all := &coretypes.ResultValidators{}
+	for page := 1; ; page++ {
+		p := page
+		res, err := c.client.Validators(ctx, height, &p, &perPage)
+		if err != nil {
+			return nil, err
+		}
+		// pin the height so every page is from the same validator set
+		height = &res.BlockHeight
+		all.BlockHeight = res.BlockHeight
+		all.Total = res.Total
+		all.Validators = append(all.Validators, res.Validators...)
+		if len(res.Validators) == 0 || len(all.Validators) >= res.Total {
+			break
+		}
+	}
+	all.Count = len(all.Validators)
+	return all, nil
+}
+
+// GetStatus returns the status of the CometBFT node, including its latest
+// block and whether it is catching up.
+func (c *Client) GetStatus(ctx context.Context) (*coretypes.ResultStatus, error) {
+	return c.client.Status(ctx)
+}
+
 func balanceResponseFromProto(resp *accountsproto.BalanceResponse) []*BalanceResponse {
 	var balanceResponses []*BalanceResponse
 	for _, balance := range resp.Balances {
@@ -0,0 +1,108 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	primproto "buf.build/gen/go/astria/primitives/protocolbuffers/go/astria/primitive/v1"
+	sequencerblockv1 "github.com/astriaorg/astria-cli-go/modules/go-sequencer-client/proto/astria/sequencerblock/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// GRPCClient is a client for the sequencer's gRPC SequencerService, which
+// serves the sequencer blocks consumed by conductor.
+type GRPCClient struct {
+	conn   *grpc.ClientConn
+	client sequencerblockv1.SequencerServiceClient
+}
+
+// NewGRPCClient creates a client for the sequencer gRPC endpoint at url.
+// https URLs are dialed with TLS, all other URLs without transport security.
+// A missing port defaults to 443 for https and 80 for http.
+func NewGRPCClient(url string) (*GRPCClient, error) {
+	target, useTLS, err := grpcTarget(url)
+	if err != nil {
+		return nil, err
+	}
+
+	creds := insecure.NewCredentials()
+	if useTLS {
+		creds = credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	}
+	conn, err := grpc.NewClient(target, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, err
+	}
+
+	return &GRPCClient{
+		conn:   conn,
+		client: sequencerblockv1.NewSequencerServiceClient(conn),
+	}, nil
+}
+
+// Close closes the underlying connection.
+func (c *GRPCClient) Close() error {
+	return c.conn.Close()
+}
+
+// GetSequencerBlock returns the sequencer block at the given height,
+// containing the transactions of every rollup.
+func (c *GRPCClient) GetSequencerBlock(ctx context.Context, height uint64) (*sequencerblockv1.SequencerBlock, error) {
+	return c.client.GetSequencerBlock(ctx, &sequencerblockv1.GetSequencerBlockRequest{
+		Height: height,
+	})
+}
+
+// GetFilteredSequencerBlock returns the sequencer block at the given height,
+// containing only the transactions of the given rollups. The IDs of all
+// rollups in the block are still listed, along with the proof of their
+// inclusion.
+func (c *GRPCClient) GetFilteredSequencerBlock(ctx context.Context, height uint64, rollupIDs [][]byte) (*sequencerblockv1.FilteredSequencerBlock, error) {
+	ids := make([]*primproto.RollupId, 0, len(rollupIDs))
+	for _, id := range rollupIDs {
+		ids = append(ids, &primproto.RollupId{Inner: id})
+	}
+	return c.client.GetFilteredSequencerBlock(ctx, &sequencerblockv1.GetFilteredSequencerBlockRequest{
+		Height:    height,
+		RollupIds: ids,
+	})
+}
+
+// grpcTarget converts a URL into a gRPC dial target and reports whether the
+// connection should use TLS. URLs without a scheme are used as is.
+func grpcTarget(rawURL string) (string, bool, error) {
+	if !strings.Contains(rawURL, "://") {
+		return rawURL, false, nil
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", false, err
+	}
+	if u.Host == "" {
+		return "", false, fmt.Errorf("missing host in grpc url: %s", rawURL)
+	}
+
+	var useTLS bool
+	port := u.Port()
+	switch u.Scheme {
+	case "https":
+		useTLS = true
+		if port == "" {
+			port = "443"
+		}
+	case "http":
+		if port == "" {
+			port = "80"
+		}
+	default:
+		return "", false, fmt.Errorf("unsupported grpc url scheme: %s", u.Scheme)
+	}
+	return net.JoinHostPort(u.Hostname(), port), useTLS, nil
+}
@@ -1,14 +1,36 @@
 package client
 
 import (
+	"fmt"
+	"math"
 	"math/big"
 
 	primproto "buf.build/gen/go/astria/primitives/protocolbuffers/go/astria/primitive/v1"
 )
 
+// maxU64 masks the low 64 bits of a big.Int.
+var maxU64 = new(big.Int).SetUint64(math.MaxUint64)
+
 func ProtoU128ToBigInt(u128 *primproto.Uint128) *big.Int {
 	lo := big.NewInt(0).SetUint64(u128.Lo)
 	hi := big.NewInt(0).SetUint64(u128.Hi)
 	hi.Lsh(hi, 64)
 	return lo.Add(lo, hi)
 }
+
+// BigIntToProtoU128 converts a big.Int to a Uint128 protobuf. It returns an
+// error if the value is negative or does not fit in 128 bits.
+func BigIntToProtoU128(i *big.Int) (*primproto.Uint128, error) {
+	if i.Sign() < 0 {
+		return nil, fmt.Errorf("negative number not allowed")
+	} else if i.BitLen() > 128 {
+		return nil, fmt.Errorf("value overflows Uint128")
+	}
+
+	lo := new(big.Int).And(i, maxU64).Uint64()
+	hi := new(big.Int).Rsh(i, 64).Uint64()
+	return &primproto.Uint128{
+		Lo: lo,
+		Hi: hi,
+	}, nil
+}
@@ -0,0 +1,19 @@
+//go:build !unix
+
+package client
+
+import (
+	"errors"
+	"os"
+)
+
+// lockFile blocks until it holds an exclusive lock on f. File locking is only
+// supported on unix systems.
+func lockFile(_ *os.File) error {
+	return errors.ErrUnsupported
+}
+
+// unlockFile releases the lock on f.
+func unlockFile(_ *os.File) error {
+	return errors.ErrUnsupported
+}
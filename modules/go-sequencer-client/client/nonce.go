@@ -0,0 +1,302 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	log "github.com/sirupsen/logrus"
+)
+
+// DefaultNonceStaleAfter is how long nonces may be reserved ahead of the
+// sequencer's nonce, without the sequencer's nonce advancing, before they are
+// considered lost. It is well above the time transactions usually take to be
+// committed, so that the nonces of slow transactions are not handed out again.
+const DefaultNonceStaleAfter = 10 * time.Minute
+
+// ABCI error codes the sequencer returns for transactions rejected because of
+// their nonce.
+const (
+	// invalidNonceCode is returned for a nonce below the account's nonce
+	invalidNonceCode = 4
+	// nonceTakenCode is returned for a nonce already used by a transaction
+	// in the mempool
+	nonceTakenCode = 15
+)
+
+// NonceManager hands out nonces for the transactions signed by an address,
+// so that several transactions can be signed and broadcast before the first
+// is included in a block.
+//
+// The next nonce of each address is persisted in a file under the manager's
+// directory and guarded by a file lock, so that concurrent processes using
+// the same directory hand out distinct nonces. Reserved nonces are reconciled
+// with the sequencer's nonce on every reservation: the manager resyncs if the
+// sequencer's nonce moved past them, or if it has not advanced for
+// StaleAfter while nonces are reserved ahead of it. StaleAfter should be well
+// above the time transactions take to be committed.
+type NonceManager struct {
+	client *Client
+	dir    string
+	// StaleAfter is how long nonces may be reserved ahead of the sequencer's
+	// nonce without it advancing before the manager resyncs.
+	StaleAfter time.Duration
+}
+
+// NonceReservation is a range of consecutive nonces reserved for an address.
+type NonceReservation struct {
+	// Nonce is the first reserved nonce
+	Nonce uint32
+	// Count is the number of reserved nonces
+	Count uint32
+
+	manager *NonceManager
+	chainID string
+	addr    string
+}
+
+// nonceState is the persisted state of an address's nonces.
+type nonceState struct {
+	// Next is the next nonce to hand out
+	Next uint32 `json:"next"`
+	// ChainNonce is the sequencer's nonce when it was last queried
+	ChainNonce uint32 `json:"chainNonce"`
+	// ChainNonceAt is when ChainNonce was last seen to change, or when no
+	// nonces were reserved ahead of it
+	ChainNonceAt time.Time `json:"chainNonceAt"`
+}
+
+// NewNonceManager returns a nonce manager that persists its state under dir
+// and queries nonces with c.
+func NewNonceManager(c *Client, dir string) *NonceManager {
+	return &NonceManager{
+		client:     c,
+		dir:        dir,
+		StaleAfter: DefaultNonceStaleAfter,
+	}
+}
+
+// Reserve reserves count consecutive nonces for addr on the chain with the
+// given ID.
+func (m *NonceManager) Reserve(ctx context.Context, chainID string, addr string, count uint32) (*NonceReservation, error) {
+	if count == 0 {
+		return nil, errors.New("must reserve at least one nonce")
+	}
+	return m.ReserveFrom(ctx, chainID, addr, 0, count)
+}
+
+// ReserveFrom is like Reserve, but the reserved nonces start no lower than
+// from. The nonces between the address's next nonce and from are skipped, as
+// they are used by transactions the caller signed earlier. A count of zero
+// only skips them.
+func (m *NonceManager) ReserveFrom(ctx context.Context, chainID string, addr string, from uint32, count uint32) (*NonceReservation, error) {
+	var first uint32
+	err := m.update(chainID, addr, func(state *nonceState) error {
+		if err := m.sync(ctx, addr, state, false); err != nil {
+			return err
+		}
+		if state.Next < from {
+			state.Next = from
+		}
+		first = state.Next
+		state.Next += count
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if count > 0 {
+		log.Debugf("Reserved nonces %d to %d for %s", first, first+count-1, addr)
+	}
+	return &NonceReservation{
+		Nonce:   first,
+		Count:   count,
+		manager: m,
+		chainID: chainID,
+		addr:    addr,
+	}, nil
+}
+
+// Resync discards the nonces reserved for addr and continues from the
+// sequencer's nonce.
+func (m *NonceManager) Resync(ctx context.Context, chainID string, addr string) error {
+	return m.update(chainID, addr, func(state *nonceState) error {
+		return m.sync(ctx, addr, state, true)
+	})
+}
+
+// sync reconciles state with the sequencer's nonce for addr. If force is
+// true, the reserved nonces are discarded.
+func (m *NonceManager) sync(ctx context.Context, addr string, state *nonceState, force bool) error {
+	chainNonce, err := m.client.GetNonce(ctx, addr)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if chainNonce != state.ChainNonce {
+		state.ChainNonce = chainNonce
+		state.ChainNonceAt = now
+	}
+	switch {
+	case force:
+		log.Debugf("Resyncing nonce for %s to %d", addr, chainNonce)
+		state.Next = chainNonce
+		state.ChainNonceAt = now
+	case state.Next <= chainNonce:
+		state.Next = chainNonce
+		state.ChainNonceAt = now
+	case now.Sub(state.ChainNonceAt) > m.StaleAfter:
+		log.Warnf("Nonces %d to %d for %s were not used within %s, resyncing to %d", chainNonce, state.Next-1, addr, m.StaleAfter, chainNonce)
+		state.Next = chainNonce
+		state.ChainNonceAt = now
+	}
+	return nil
+}
+
+// Release returns the nonces of the reservation from nonce onwards, if no
+// later reservation was made for the address. It is used when transactions
+// using those nonces were not accepted by the sequencer.
+func (r *NonceReservation) Release(nonce uint32) error {
+	if r.manager == nil || nonce < r.Nonce || nonce >= r.Nonce+r.Count {
+		return nil
+	}
+	return r.manager.update(r.chainID, r.addr, func(state *nonceState) error {
+		if state.Next == r.Nonce+r.Count {
+			state.Next = nonce
+		}
+		return nil
+	})
+}
+
+// Resync discards the nonces reserved for the reservation's address and
+// continues from the sequencer's nonce. It is used when a transaction using
+// a reserved nonce was rejected for its nonce.
+func (r *NonceReservation) Resync(ctx context.Context) error {
+	if r.manager == nil {
+		return nil
+	}
+	return r.manager.Resync(ctx, r.chainID, r.addr)
+}
+
+// Done records the outcome of broadcasting the transaction using the
+// reservation's first nonce. If the sequencer rejected the nonce, the manager
+// resyncs with the sequencer. If it rejected the transaction for another
+// reason, the nonce is released. If broadcasting failed without a response,
+// the nonce is released unless the sequencer's nonce shows it was used, as
+// the transaction most likely never reached the sequencer and later
+// transactions would wait on its nonce.
+func (r *NonceReservation) Done(ctx context.Context, resp *coretypes.ResultBroadcastTx, err error) {
+	if r.manager == nil || err == nil {
+		return
+	}
+	// broadcasting may have failed because ctx expired
+	if ctx.Err() != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+	}
+
+	var updateErr error
+	switch {
+	case IsNonceError(resp):
+		updateErr = r.Resync(ctx)
+	case resp != nil && resp.Code != 0:
+		updateErr = r.Release(r.Nonce)
+	case resp == nil:
+		updateErr = r.releaseUnused(ctx)
+	}
+	if updateErr != nil {
+		log.WithError(updateErr).Warn("Error updating nonce state")
+	}
+}
+
+// releaseUnused releases the reservation's nonces if the sequencer's nonce
+// shows that the first one was not used.
+func (r *NonceReservation) releaseUnused(ctx context.Context) error {
+	chainNonce, err := r.manager.client.GetNonce(ctx, r.addr)
+	if err != nil {
+		return err
+	}
+	if chainNonce > r.Nonce {
+		return nil
+	}
+	log.Debugf("Nonce %d for %s was not used, releasing it", r.Nonce, r.addr)
+	return r.Release(r.Nonce)
+}
+
+// IsNonceError returns true if the sequencer rejected a transaction because
+// of its nonce.
+func IsNonceError(resp *coretypes.ResultBroadcastTx) bool {
+	return resp != nil && (resp.Code == invalidNonceCode || resp.Code == nonceTakenCode)
+}
+
+// update applies fn to the persisted state of addr while holding the state
+// file's lock.
+func (m *NonceManager) update(chainID string, addr string, fn func(*nonceState) error) error {
+	dir := filepath.Join(m.dir, sanitizeFileName(chainID))
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(filepath.Join(dir, sanitizeFileName(addr)+".json"), os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := lockFile(f); err != nil {
+		return fmt.Errorf("failed to lock nonce state: %w", err)
+	}
+	defer func() {
+		if err := unlockFile(f); err != nil {
+			log.WithError(err).Warn("Error unlocking nonce state")
+		}
+	}()
+
+	state := &nonceState{}
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return err
+	}
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, state); err != nil {
+			log.WithError(err).Warn("Ignoring invalid nonce state")
+			state = &nonceState{}
+		}
+	}
+
+	if err := fn(state); err != nil {
+		return err
+	}
+
+	data, err = json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	if err := f.Truncate(0); err != nil {
+		return err
+	}
+	if _, err := f.WriteAt(data, 0); err != nil {
+		return err
+	}
+	return f.Sync()
+}
+
+// sanitizeFileName replaces the characters of name that are not safe to use
+// in a file name.
+func sanitizeFileName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '/' || r == '\\' || r == ':' || r == 0 {
+			return '_'
+		}
+		return r
+	}, name)
+}
@@ -0,0 +1,193 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	assetproto "buf.build/gen/go/astria/protocol-apis/protocolbuffers/go/astria/protocol/asset/v1"
+	bridgeproto "buf.build/gen/go/astria/protocol-apis/protocolbuffers/go/astria/protocol/bridge/v1"
+	feesproto "buf.build/gen/go/astria/protocol-apis/protocolbuffers/go/astria/protocol/fees/v1"
+	txproto "buf.build/gen/go/astria/protocol-apis/protocolbuffers/go/astria/protocol/transaction/v1"
+	"github.com/cometbft/cometbft/rpc/client"
+	"google.golang.org/protobuf/proto"
+)
+
+// DenomResponse describes the response from an asset denom query.
+type DenomResponse struct {
+	Height uint64 `json:"height"`
+	Denom  string `json:"denom"`
+}
+
+// AllowedFeeAssetsResponse describes the response from an allowed fee assets
+// query.
+type AllowedFeeAssetsResponse struct {
+	Height    uint64   `json:"height"`
+	FeeAssets []string `json:"feeAssets"`
+}
+
+// TransactionFee is the fee charged in a single asset.
+type TransactionFee struct {
+	Asset string   `json:"asset"`
+	Fee   *big.Int `json:"fee"`
+}
+
+// TransactionFeeResponse describes the response from a transaction fee
+// query.
+type TransactionFeeResponse struct {
+	Height uint64            `json:"height"`
+	Fees   []*TransactionFee `json:"fees"`
+}
+
+// BridgeAccountInfoResponse describes the response from a bridge account info
+// query. IsBridge is false, and the other fields empty, if the address is not
+// a bridge account.
+type BridgeAccountInfoResponse struct {
+	Height            uint64 `json:"height"`
+	IsBridge          bool   `json:"isBridge"`
+	RollupID          []byte `json:"rollupId,omitempty"`
+	Asset             string `json:"asset,omitempty"`
+	SudoAddress       string `json:"sudoAddress,omitempty"`
+	WithdrawerAddress string `json:"withdrawerAddress,omitempty"`
+}
+
+// BridgeAccountLastTxHashResponse describes the response from a bridge
+// account last transaction hash query. TxHash is nil if the bridge account
+// has not been used.
+type BridgeAccountLastTxHashResponse struct {
+	Height uint64 `json:"height"`
+	TxHash []byte `json:"txHash,omitempty"`
+}
+
+// ABCIInfoResponse describes the application info reported by the
+// sequencer, including the last committed block.
+type ABCIInfoResponse struct {
+	Data             string `json:"data"`
+	Version          string `json:"version"`
+	AppVersion       uint64 `json:"appVersion"`
+	LastBlockHeight  int64  `json:"lastBlockHeight"`
+	LastBlockAppHash []byte `json:"lastBlockAppHash"`
+}
+
+// abciQuery runs an ABCI query against the latest state and decodes the
+// response value into resp.
+func (c *Client) abciQuery(ctx context.Context, path string, data []byte, resp proto.Message) error {
+	res, err := c.client.ABCIQueryWithOptions(ctx, path, data, client.ABCIQueryOptions{
+		Height: 0,
+		Prove:  false,
+	})
+	if err != nil {
+		return err
+	}
+
+	if res.Response.Code != 0 {
+		return errors.New(res.Response.Log)
+	}
+
+	return proto.Unmarshal(res.Response.Value, resp)
+}
+
+// GetDenom returns the denom of the asset with the given hex encoded ID.
+func (c *Client) GetDenom(ctx context.Context, assetID string) (*DenomResponse, error) {
+	resp := &assetproto.DenomResponse{}
+	if err := c.abciQuery(ctx, "asset/denom/"+assetID, []byte{}, resp); err != nil {
+		return nil, err
+	}
+
+	return &DenomResponse{
+		Height: resp.Height,
+		Denom:  resp.Denom,
+	}, nil
+}
+
+// GetAllowedFeeAssets returns the assets that can be used to pay fees.
+func (c *Client) GetAllowedFeeAssets(ctx context.Context) (*AllowedFeeAssetsResponse, error) {
+	resp := &assetproto.AllowedFeeAssetsResponse{}
+	if err := c.abciQuery(ctx, "asset/allowed_fee_assets", []byte{}, resp); err != nil {
+		return nil, err
+	}
+
+	return &AllowedFeeAssetsResponse{
+		Height:    resp.Height,
+		FeeAssets: resp.FeeAssets,
+	}, nil
+}
+
+// GetTransactionFee returns the fees that would be charged for executing the
+// given transaction body, per fee asset.
+func (c *Client) GetTransactionFee(ctx context.Context, tx *txproto.TransactionBody) (*TransactionFeeResponse, error) {
+	data, err := proto.Marshal(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &feesproto.TransactionFeeResponse{}
+	if err := c.abciQuery(ctx, "transaction/fee", data, resp); err != nil {
+		return nil, err
+	}
+
+	fees := make([]*TransactionFee, 0, len(resp.Fees))
+	for _, fee := range resp.Fees {
+		amount := big.NewInt(0)
+		if fee.Fee != nil {
+			amount = ProtoU128ToBigInt(fee.Fee)
+		}
+		fees = append(fees, &TransactionFee{
+			Asset: fee.Asset,
+			Fee:   amount,
+		})
+	}
+	return &TransactionFeeResponse{
+		Height: resp.Height,
+		Fees:   fees,
+	}, nil
+}
+
+// GetBridgeAccountInfo returns the rollup, asset and privileged addresses of
+// a bridge account.
+func (c *Client) GetBridgeAccountInfo(ctx context.Context, addr string) (*BridgeAccountInfoResponse, error) {
+	resp := &bridgeproto.BridgeAccountInfoResponse{}
+	if err := c.abciQuery(ctx, "bridge/account_info/"+addr, []byte{}, resp); err != nil {
+		return nil, err
+	}
+
+	return &BridgeAccountInfoResponse{
+		Height:            resp.Height,
+		IsBridge:          resp.RollupId != nil,
+		RollupID:          resp.GetRollupId().GetInner(),
+		Asset:             resp.GetAsset(),
+		SudoAddress:       resp.GetSudoAddress().GetBech32M(),
+		WithdrawerAddress: resp.GetWithdrawerAddress().GetBech32M(),
+	}, nil
+}
+
+// GetBridgeAccountLastTxHash returns the hash of the last transaction that
+// used the bridge account.
+func (c *Client) GetBridgeAccountLastTxHash(ctx context.Context, addr string) (*BridgeAccountLastTxHashResponse, error) {
+	resp := &bridgeproto.BridgeAccountLastTxHashResponse{}
+	if err := c.abciQuery(ctx, "bridge/account_last_tx_hash/"+addr, []byte{}, resp); err != nil {
+		return nil, err
+	}
+
+	return &BridgeAccountLastTxHashResponse{
+		Height: resp.Height,
+		TxHash: resp.TxHash,
+	}, nil
+}
+
+// GetABCIInfo returns the application version and the last committed block
+// reported by the sequencer.
+func (c *Client) GetABCIInfo(ctx context.Context) (*ABCIInfoResponse, error) {
+	info, err := c.client.ABCIInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ABCIInfoResponse{
+		Data:             info.Response.Data,
+		Version:          info.Response.Version,
+		AppVersion:       info.Response.AppVersion,
+		LastBlockHeight:  info.Response.LastBlockHeight,
+		LastBlockAppHash: info.Response.LastBlockAppHash,
+	}, nil
+}
@@ -0,0 +1,334 @@
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"time"
+
+	txproto "buf.build/gen/go/astria/protocol-apis/protocolbuffers/go/astria/protocol/transaction/v1"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	jsonrpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
+	cmttypes "github.com/cometbft/cometbft/types"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// NewBlockQuery is the CometBFT event query matching every new block.
+	NewBlockQuery = "tm.event = 'NewBlock'"
+	// TxQuery is the CometBFT event query matching every executed transaction.
+	TxQuery = "tm.event = 'Tx'"
+
+	websocketEndpoint = "/websocket"
+	// the websocket client backs off exponentially between its own reconnect
+	// attempts, so keep them few and fall back to redialing with a new client
+	websocketReconnectAttempts = 3
+	websocketRedialInterval    = 2 * time.Second
+	websocketPingPeriod        = 10 * time.Second
+	websocketReadWait          = 30 * time.Second
+)
+
+// TxFilter selects the transactions delivered by SubscribeTxs. A zero value
+// matches every transaction.
+type TxFilter struct {
+	// Address is a bech32m address. If set, only transactions with an action
+	// referencing the address match, unless the transaction was signed by
+	// Signer.
+	Address string
+	// Signer is the raw 20 byte address of a signer. If set, transactions
+	// signed by the address match.
+	Signer []byte
+	// ActionType is the name of an action, ie. "transfer". If set, only
+	// transactions containing an action of that type match.
+	ActionType string
+}
+
+// TxEvent is a transaction delivered by SubscribeTxs.
+type TxEvent struct {
+	// Hash is the hash of the encoded transaction
+	Hash []byte
+	// Height is the height of the block the transaction was included in
+	Height int64
+	// Index is the index of the transaction within the block
+	Index uint32
+	// Result is the execution result of the transaction
+	Result abci.ExecTxResult
+	// Transaction is the decoded signed transaction
+	Transaction *txproto.Transaction
+	// Body is the decoded body of the transaction
+	Body *txproto.TransactionBody
+}
+
+// Subscribe subscribes to the CometBFT events matching query and sends them
+// on the returned channel until ctx is done, at which point the channel is
+// closed. Dropped websocket connections are re-established and resubscribed
+// automatically. An error is returned only if the initial subscription fails.
+func (c *Client) Subscribe(ctx context.Context, query string) (<-chan coretypes.ResultEvent, error) {
+	ws, err := c.dialSubscription(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan coretypes.ResultEvent)
+	go func() {
+		defer close(out)
+		for {
+			forwardEvents(ctx, ws, out)
+			if err := ws.Stop(); err != nil {
+				log.WithError(err).Debug("error stopping websocket client")
+			}
+
+			// redial until the subscription is re-established or ctx is done
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(websocketRedialInterval):
+				}
+				ws, err = c.dialSubscription(ctx, query)
+				if err == nil {
+					log.Debug("websocket subscription re-established")
+					break
+				}
+				log.WithError(err).Debug("error re-establishing websocket subscription, retrying...")
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// SubscribeBlocks sends every new block on the returned channel until ctx is
+// done. See Subscribe.
+func (c *Client) SubscribeBlocks(ctx context.Context) (<-chan cmttypes.EventDataNewBlock, error) {
+	events, err := c.Subscribe(ctx, NewBlockQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan cmttypes.EventDataNewBlock)
+	go func() {
+		defer close(out)
+		for event := range events {
+			block, ok := event.Data.(cmttypes.EventDataNewBlock)
+			if !ok {
+				continue
+			}
+			select {
+			case out <- block:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// SubscribeTxs sends every executed transaction matching filter on the
+// returned channel until ctx is done. See Subscribe.
+func (c *Client) SubscribeTxs(ctx context.Context, filter TxFilter) (<-chan *TxEvent, error) {
+	events, err := c.Subscribe(ctx, TxQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan *TxEvent)
+	go func() {
+		defer close(out)
+		for event := range events {
+			data, ok := event.Data.(cmttypes.EventDataTx)
+			if !ok {
+				continue
+			}
+			tx := txEventFromResult(data.TxResult)
+			if !filter.Matches(tx) {
+				continue
+			}
+			select {
+			case out <- tx:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// Matches returns true if the transaction passes the filter. Transactions
+// that could not be decoded only match the zero filter.
+func (f TxFilter) Matches(tx *TxEvent) bool {
+	if f.Address == "" && len(f.Signer) == 0 && f.ActionType == "" {
+		return true
+	}
+	if tx.Body == nil {
+		return false
+	}
+
+	if f.ActionType != "" {
+		found := false
+		for _, action := range tx.Body.Actions {
+			if oneofName(action.ProtoReflect(), "value") == f.ActionType {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if f.Address == "" && len(f.Signer) == 0 {
+		return true
+	}
+	if len(f.Signer) != 0 {
+		hash := sha256.Sum256(tx.Transaction.PublicKey)
+		if string(hash[:20]) == string(f.Signer) {
+			return true
+		}
+	}
+	if f.Address != "" {
+		for _, action := range tx.Body.Actions {
+			if referencesAddress(action.ProtoReflect(), f.Address) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// dialSubscription starts a websocket client subscribed to query. The client
+// resubscribes after reconnecting, since CometBFT drops a connection's
+// subscriptions when it closes.
+func (c *Client) dialSubscription(ctx context.Context, query string) (*jsonrpcclient.WSClient, error) {
+	var ws *jsonrpcclient.WSClient
+	ws, err := jsonrpcclient.NewWS(c.websocket, websocketEndpoint,
+		jsonrpcclient.MaxReconnectAttempts(websocketReconnectAttempts),
+		jsonrpcclient.PingPeriod(websocketPingPeriod),
+		jsonrpcclient.ReadWait(websocketReadWait),
+		jsonrpcclient.OnReconnect(func() {
+			if err := ws.Subscribe(context.Background(), query); err != nil {
+				log.WithError(err).Debug("error resubscribing after reconnect")
+			}
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := ws.Start(); err != nil {
+		return nil, err
+	}
+	if err := ws.Subscribe(ctx, query); err != nil {
+		_ = ws.Stop()
+		return nil, err
+	}
+	return ws, nil
+}
+
+// forwardEvents sends the events received by ws to out until ctx is done or
+// ws gives up reconnecting and closes its responses channel.
+func forwardEvents(ctx context.Context, ws *jsonrpcclient.WSClient, out chan<- coretypes.ResultEvent) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case resp, ok := <-ws.ResponsesCh:
+			if !ok {
+				return
+			}
+			if resp.Error != nil {
+				log.WithError(resp.Error).Debug("websocket subscription error")
+				continue
+			}
+
+			event := coretypes.ResultEvent{}
+			if err := cmtjson.Unmarshal(resp.Result, &event); err != nil {
+				log.WithError(err).Debug("error decoding websocket event")
+				continue
+			}
+			// subscription confirmations have an empty result
+			if event.Data == nil {
+				continue
+			}
+			select {
+			case out <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// txEventFromResult builds a TxEvent, decoding the transaction if possible.
+func txEventFromResult(result abci.TxResult) *TxEvent {
+	hash := sha256.Sum256(result.Tx)
+	event := &TxEvent{
+		Hash:   hash[:],
+		Height: result.Height,
+		Index:  result.Index,
+		Result: result.Result,
+	}
+
+	tx := &txproto.Transaction{}
+	if err := proto.Unmarshal(result.Tx, tx); err != nil {
+		log.WithError(err).Debug("error decoding transaction")
+		return event
+	}
+	body := &txproto.TransactionBody{}
+	if err := tx.GetBody().UnmarshalTo(body); err != nil {
+		log.WithError(err).Debug("error decoding transaction body")
+		return event
+	}
+	event.Transaction = tx
+	event.Body = body
+	return event
+}
+
+// oneofName returns the name of the field set in the named oneof of m, or an
+// empty string if none is set.
+func oneofName(m protoreflect.Message, oneof string) string {
+	od := m.Descriptor().Oneofs().ByName(protoreflect.Name(oneof))
+	if od == nil {
+		return ""
+	}
+	field := m.WhichOneof(od)
+	if field == nil {
+		return ""
+	}
+	return string(field.Name())
+}
+
+// referencesAddress returns true if any Address message nested within m has
+// the given bech32m encoding.
+func referencesAddress(m protoreflect.Message, address string) bool {
+	if m.Descriptor().FullName() == "astria.primitive.v1.Address" {
+		return m.Get(m.Descriptor().Fields().ByName("bech32m")).String() == address
+	}
+
+	found := false
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Kind() != protoreflect.MessageKind || fd.IsMap() {
+			return true
+		}
+		if fd.IsList() {
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				if referencesAddress(list.Get(i).Message(), address) {
+					found = true
+					return false
+				}
+			}
+			return true
+		}
+		if referencesAddress(v.Message(), address) {
+			found = true
+			return false
+		}
+		return true
+	})
+	return found
+}
@@ -0,0 +1,68 @@
+package client
+
+import (
+	"fmt"
+	"time"
+)
+
+// WaitMode selects how long broadcasting a transaction waits for.
+type WaitMode string
+
+const (
+	// WaitCommit waits until the transaction was included in a block and
+	// the required confirmations were committed.
+	WaitCommit WaitMode = "commit"
+	// WaitSync waits until the transaction passed CheckTx.
+	WaitSync WaitMode = "sync"
+	// WaitNone returns as soon as the transaction was submitted.
+	WaitNone WaitMode = "none"
+)
+
+const (
+	// DefaultWaitTimeout is how long to wait for a transaction to be
+	// included and confirmed if no timeout is set.
+	DefaultWaitTimeout = 30 * time.Second
+	// DefaultWaitPollInterval is how often to poll for a transaction if no
+	// poll interval is set.
+	DefaultWaitPollInterval = 250 * time.Millisecond
+)
+
+// ParseWaitMode parses a wait mode from its name.
+func ParseWaitMode(mode string) (WaitMode, error) {
+	switch WaitMode(mode) {
+	case WaitCommit, WaitSync, WaitNone:
+		return WaitMode(mode), nil
+	default:
+		return "", fmt.Errorf("invalid wait mode %q, must be one of: %s, %s, %s", mode, WaitCommit, WaitSync, WaitNone)
+	}
+}
+
+// WaitOptions configures how long broadcasting a transaction waits for.
+type WaitOptions struct {
+	// Mode selects what to wait for. The zero value waits for commit.
+	Mode WaitMode
+	// Timeout is the maximum time to wait for the transaction to be
+	// included and confirmed. Defaults to DefaultWaitTimeout.
+	Timeout time.Duration
+	// PollInterval is the time between polls for the transaction. Defaults
+	// to DefaultWaitPollInterval.
+	PollInterval time.Duration
+	// Confirmations is the number of blocks that must be committed after
+	// the block including the transaction.
+	Confirmations int64
+}
+
+// withDefaults returns a copy of the options with unset fields set to their
+// defaults.
+func (o WaitOptions) withDefaults() WaitOptions {
+	if o.Mode == "" {
+		o.Mode = WaitCommit
+	}
+	if o.Timeout <= 0 {
+		o.Timeout = DefaultWaitTimeout
+	}
+	if o.PollInterval <= 0 {
+		o.PollInterval = DefaultWaitPollInterval
+	}
+	return o
+}
@@ -8,6 +8,7 @@ require (
 	github.com/cometbft/cometbft v0.38.12
 	github.com/sirupsen/logrus v1.9.3
 	github.com/stretchr/testify v1.9.0
+	google.golang.org/grpc v1.64.1
 	google.golang.org/protobuf v1.35.1
 )
 
@@ -67,6 +68,5 @@ require (
 	golang.org/x/sys v0.23.0 // indirect
 	golang.org/x/text v0.17.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20240318140521-94a12d6c2237 // indirect
-	google.golang.org/grpc v1.64.1 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
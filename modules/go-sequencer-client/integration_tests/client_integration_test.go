@@ -6,6 +6,7 @@ package integration_tests
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/astriaorg/astria-cli-go/modules/go-sequencer-client/client"
 	"github.com/stretchr/testify/require"
@@ -28,3 +29,100 @@ func TestGetNonce(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, nonce, uint32(0))
 }
+
+func TestGetTxNotFound(t *testing.T) {
+	c, err := client.NewClient("http://localhost:26657")
+	require.NoError(t, err)
+
+	_, err = c.GetTx(context.Background(), make([]byte, 32))
+	require.Error(t, err)
+}
+
+func TestSubscribeBlocks(t *testing.T) {
+	c, err := client.NewClient("http://localhost:26657")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	blocks, err := c.SubscribeBlocks(ctx)
+	require.NoError(t, err)
+
+	block, ok := <-blocks
+	require.True(t, ok, "should receive a block before the timeout")
+	require.NotNil(t, block.Block)
+}
+
+func TestGetAllowedFeeAssets(t *testing.T) {
+	c, err := client.NewClient("http://localhost:26657")
+	require.NoError(t, err)
+
+	assets, err := c.GetAllowedFeeAssets(context.Background())
+	require.NoError(t, err)
+	require.NotEmpty(t, assets.FeeAssets)
+}
+
+func TestGetBridgeAccountInfoNotBridge(t *testing.T) {
+	c, err := client.NewClient("http://localhost:26657")
+	require.NoError(t, err)
+
+	info, err := c.GetBridgeAccountInfo(context.Background(), "astria1hj8pc8vwcvrr7wswjulemzls4cm9mj5w5858df")
+	require.NoError(t, err)
+	require.False(t, info.IsBridge)
+}
+
+func TestWaitForTxTimeout(t *testing.T) {
+	c, err := client.NewClient("http://localhost:26657")
+	require.NoError(t, err)
+
+	_, err = c.WaitForTx(context.Background(), make([]byte, 32), client.WaitOptions{Timeout: time.Second})
+	require.ErrorContains(t, err, "not found after 1s")
+}
+
+func TestNonceManagerReserve(t *testing.T) {
+	c, err := client.NewClient("http://localhost:26657")
+	require.NoError(t, err)
+
+	manager := client.NewNonceManager(c, t.TempDir())
+	first, err := manager.Reserve(context.Background(), "sequencer-test-chain-0", "astria1hj8pc8vwcvrr7wswjulemzls4cm9mj5w5858df", 2)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), first.Nonce)
+
+	second, err := manager.Reserve(context.Background(), "sequencer-test-chain-0", "astria1hj8pc8vwcvrr7wswjulemzls4cm9mj5w5858df", 1)
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), second.Nonce)
+}
+
+func TestGetValidators(t *testing.T) {
+	c, err := client.NewClient("http://localhost:26657")
+	require.NoError(t, err)
+
+	validators, err := c.GetValidators(context.Background(), nil)
+	require.NoError(t, err)
+	require.NotEmpty(t, validators.Validators)
+	require.Equal(t, validators.Total, validators.Count)
+}
+
+func TestGetStatus(t *testing.T) {
+	c, err := client.NewClient("http://localhost:26657")
+	require.NoError(t, err)
+
+	status, err := c.GetStatus(context.Background())
+	require.NoError(t, err)
+	require.Positive(t, status.SyncInfo.LatestBlockHeight)
+}
+
+func TestGetSequencerBlock(t *testing.T) {
+	c, err := client.NewGRPCClient("http://localhost:8080")
+	require.NoError(t, err)
+	defer c.Close()
+
+	block, err := c.GetSequencerBlock(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), block.GetHeader().GetHeight())
+	require.NotNil(t, block.GetRollupTransactionsProof())
+
+	filtered, err := c.GetFilteredSequencerBlock(context.Background(), 1, [][]byte{make([]byte, 32)})
+	require.NoError(t, err)
+	require.Empty(t, filtered.GetRollupTransactions())
+}
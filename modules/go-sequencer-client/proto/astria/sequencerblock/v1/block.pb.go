@@ -0,0 +1,789 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.1
+// 	protoc        (unknown)
+// source: astria/sequencerblock/v1/block.proto
+
+package sequencerblockv1
+
+import (
+	v1 "buf.build/gen/go/astria/primitives/protocolbuffers/go/astria/primitive/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// `RollupTransactions` are a sequence of opaque bytes together with a 32 byte
+// identifier of that rollup.
+//
+// The binary encoding is understood as an implementation detail of the
+// services sending and receiving the transactions.
+type RollupTransactions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The 32 bytes identifying a rollup. Usually the sha256 hash of a plain rollup name.
+	RollupId *v1.RollupId `protobuf:"bytes,1,opt,name=rollup_id,json=rollupId,proto3" json:"rollup_id,omitempty"`
+	// The serialized bytes of the rollup data.
+	// Each entry is a protobuf-encoded `RollupData` message.
+	Transactions [][]byte `protobuf:"bytes,2,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	// The proof that these rollup transactions are included in sequencer block.
+	// `astria_sequencer_types::sequencer_block::SequencerBlock::rollup_transactions_proof`.
+	Proof *v1.Proof `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (x *RollupTransactions) Reset() {
+	*x = RollupTransactions{}
+	mi := &file_astria_sequencerblock_v1_block_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollupTransactions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollupTransactions) ProtoMessage() {}
+
+func (x *RollupTransactions) ProtoReflect() protoreflect.Message {
+	mi := &file_astria_sequencerblock_v1_block_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollupTransactions.ProtoReflect.Descriptor instead.
+func (*RollupTransactions) Descriptor() ([]byte, []int) {
+	return file_astria_sequencerblock_v1_block_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RollupTransactions) GetRollupId() *v1.RollupId {
+	if x != nil {
+		return x.RollupId
+	}
+	return nil
+}
+
+func (x *RollupTransactions) GetTransactions() [][]byte {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *RollupTransactions) GetProof() *v1.Proof {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+// `SequencerBlock` is constructed from a tendermint/cometbft block by
+// converting its opaque `data` bytes into sequencer specific types.
+type SequencerBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the block header, which contains cometbft header info and additional sequencer-specific
+	// commitments.
+	Header *SequencerBlockHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// The collection of rollup transactions that were included in this block.
+	RollupTransactions []*RollupTransactions `protobuf:"bytes,2,rep,name=rollup_transactions,json=rollupTransactions,proto3" json:"rollup_transactions,omitempty"`
+	// The proof that the rollup transactions are included in the CometBFT block this
+	// sequencer block is derived form. This proof together with
+	// `Sha256(MTH(rollup_transactions))` must match `header.data_hash`.
+	// `MTH(rollup_transactions)` is the Merkle Tree Hash derived from the
+	// rollup transactions.
+	RollupTransactionsProof *v1.Proof `protobuf:"bytes,3,opt,name=rollup_transactions_proof,json=rollupTransactionsProof,proto3" json:"rollup_transactions_proof,omitempty"`
+	// The proof that the rollup IDs listed in `rollup_transactions` are included
+	// in the CometBFT block this sequencer block is derived form.
+	//
+	// This proof is used to verify that the relayer that posts to celestia
+	// includes all rollup IDs and does not censor any.
+	//
+	// This proof together with `Sha256(MTH(rollup_ids))` must match `header.data_hash`.
+	// `MTH(rollup_ids)` is the Merkle Tree Hash derived from the rollup IDs listed in
+	// the rollup transactions.
+	RollupIdsProof *v1.Proof `protobuf:"bytes,4,opt,name=rollup_ids_proof,json=rollupIdsProof,proto3" json:"rollup_ids_proof,omitempty"`
+	// The block hash of the cometbft block that this sequencer block is derived from.
+	BlockHash []byte `protobuf:"bytes,5,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+}
+
+func (x *SequencerBlock) Reset() {
+	*x = SequencerBlock{}
+	mi := &file_astria_sequencerblock_v1_block_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SequencerBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SequencerBlock) ProtoMessage() {}
+
+func (x *SequencerBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_astria_sequencerblock_v1_block_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SequencerBlock.ProtoReflect.Descriptor instead.
+func (*SequencerBlock) Descriptor() ([]byte, []int) {
+	return file_astria_sequencerblock_v1_block_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SequencerBlock) GetHeader() *SequencerBlockHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *SequencerBlock) GetRollupTransactions() []*RollupTransactions {
+	if x != nil {
+		return x.RollupTransactions
+	}
+	return nil
+}
+
+func (x *SequencerBlock) GetRollupTransactionsProof() *v1.Proof {
+	if x != nil {
+		return x.RollupTransactionsProof
+	}
+	return nil
+}
+
+func (x *SequencerBlock) GetRollupIdsProof() *v1.Proof {
+	if x != nil {
+		return x.RollupIdsProof
+	}
+	return nil
+}
+
+func (x *SequencerBlock) GetBlockHash() []byte {
+	if x != nil {
+		return x.BlockHash
+	}
+	return nil
+}
+
+type SequencerBlockHeader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the cometbft chain ID of the sequencer chain
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// the height of this sequencer block
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// the timestamp of this sequencer block
+	Time *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	// the data_hash of the sequencer block (merkle root of all transactions)
+	DataHash []byte `protobuf:"bytes,4,opt,name=data_hash,json=dataHash,proto3" json:"data_hash,omitempty"`
+	// the cometbft proposer address of the sequencer block
+	ProposerAddress []byte `protobuf:"bytes,5,opt,name=proposer_address,json=proposerAddress,proto3" json:"proposer_address,omitempty"`
+	// The 32-byte merkle root of all the rollup transactions in the block,
+	// Corresponds to `MHT(astria.SequencerBlock.rollup_transactions)`,
+	RollupTransactionsRoot []byte `protobuf:"bytes,6,opt,name=rollup_transactions_root,json=rollupTransactionsRoot,proto3" json:"rollup_transactions_root,omitempty"`
+}
+
+func (x *SequencerBlockHeader) Reset() {
+	*x = SequencerBlockHeader{}
+	mi := &file_astria_sequencerblock_v1_block_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SequencerBlockHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SequencerBlockHeader) ProtoMessage() {}
+
+func (x *SequencerBlockHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_astria_sequencerblock_v1_block_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SequencerBlockHeader.ProtoReflect.Descriptor instead.
+func (*SequencerBlockHeader) Descriptor() ([]byte, []int) {
+	return file_astria_sequencerblock_v1_block_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SequencerBlockHeader) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *SequencerBlockHeader) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *SequencerBlockHeader) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *SequencerBlockHeader) GetDataHash() []byte {
+	if x != nil {
+		return x.DataHash
+	}
+	return nil
+}
+
+func (x *SequencerBlockHeader) GetProposerAddress() []byte {
+	if x != nil {
+		return x.ProposerAddress
+	}
+	return nil
+}
+
+func (x *SequencerBlockHeader) GetRollupTransactionsRoot() []byte {
+	if x != nil {
+		return x.RollupTransactionsRoot
+	}
+	return nil
+}
+
+type Deposit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BridgeAddress *v1.Address  `protobuf:"bytes,1,opt,name=bridge_address,json=bridgeAddress,proto3" json:"bridge_address,omitempty"`
+	RollupId      *v1.RollupId `protobuf:"bytes,2,opt,name=rollup_id,json=rollupId,proto3" json:"rollup_id,omitempty"`
+	Amount        *v1.Uint128  `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Asset         string       `protobuf:"bytes,4,opt,name=asset,proto3" json:"asset,omitempty"`
+	// the address on the destination chain which
+	// will receive the bridged funds
+	DestinationChainAddress string `protobuf:"bytes,5,opt,name=destination_chain_address,json=destinationChainAddress,proto3" json:"destination_chain_address,omitempty"`
+	// the transaction ID of the source action for the deposit, consisting
+	// of the transaction hash.
+	SourceTransactionId *v1.TransactionId `protobuf:"bytes,6,opt,name=source_transaction_id,json=sourceTransactionId,proto3" json:"source_transaction_id,omitempty"`
+	// index of the deposit's source action within its transaction
+	SourceActionIndex uint64 `protobuf:"varint,7,opt,name=source_action_index,json=sourceActionIndex,proto3" json:"source_action_index,omitempty"`
+}
+
+func (x *Deposit) Reset() {
+	*x = Deposit{}
+	mi := &file_astria_sequencerblock_v1_block_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Deposit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Deposit) ProtoMessage() {}
+
+func (x *Deposit) ProtoReflect() protoreflect.Message {
+	mi := &file_astria_sequencerblock_v1_block_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Deposit.ProtoReflect.Descriptor instead.
+func (*Deposit) Descriptor() ([]byte, []int) {
+	return file_astria_sequencerblock_v1_block_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Deposit) GetBridgeAddress() *v1.Address {
+	if x != nil {
+		return x.BridgeAddress
+	}
+	return nil
+}
+
+func (x *Deposit) GetRollupId() *v1.RollupId {
+	if x != nil {
+		return x.RollupId
+	}
+	return nil
+}
+
+func (x *Deposit) GetAmount() *v1.Uint128 {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Deposit) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *Deposit) GetDestinationChainAddress() string {
+	if x != nil {
+		return x.DestinationChainAddress
+	}
+	return ""
+}
+
+func (x *Deposit) GetSourceTransactionId() *v1.TransactionId {
+	if x != nil {
+		return x.SourceTransactionId
+	}
+	return nil
+}
+
+func (x *Deposit) GetSourceActionIndex() uint64 {
+	if x != nil {
+		return x.SourceActionIndex
+	}
+	return 0
+}
+
+// `FilteredSequencerBlock` is similar to `SequencerBlock` but with a subset
+// of the rollup transactions.
+type FilteredSequencerBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The original CometBFT header that was the input to this sequencer block.
+	BlockHash []byte `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// the block header, which contains sequencer-specific commitments.
+	Header *SequencerBlockHeader `protobuf:"bytes,2,opt,name=header,proto3" json:"header,omitempty"`
+	// A subset of rollup transactions that were included in this block.
+	RollupTransactions []*RollupTransactions `protobuf:"bytes,3,rep,name=rollup_transactions,json=rollupTransactions,proto3" json:"rollup_transactions,omitempty"`
+	// The proof that the rollup transactions are included in the CometBFT block this
+	// sequencer block is derived form. This proof together with
+	// `rollup_transactions_root = Sha256(MTH(rollup_transactions))` must match `header.data_hash`.
+	// `MTH(rollup_transactions)` is the Merkle Tree Hash derived from the
+	// rollup transactions.
+	RollupTransactionsProof *v1.Proof `protobuf:"bytes,4,opt,name=rollup_transactions_proof,json=rollupTransactionsProof,proto3" json:"rollup_transactions_proof,omitempty"`
+	// The rollup IDs for which `CelestiaRollupBlob`s were submitted to celestia.
+	// Corresponds to the `astria.sequencer.v1.RollupTransactions.rollup_id` field
+	// and is extracted from `astria.SequencerBlock.rollup_transactions`.
+	// Note that these are all the rollup IDs in the sequencer block, not merely those in
+	// `rollup_transactions` field. This is necessary to prove that no rollup IDs were omitted.
+	AllRollupIds []*v1.RollupId `protobuf:"bytes,5,rep,name=all_rollup_ids,json=allRollupIds,proto3" json:"all_rollup_ids,omitempty"`
+	// The proof that the `rollup_ids` are included
+	// in the CometBFT block this sequencer block is derived form.
+	//
+	// This proof is used to verify that the relayer that posts to celestia
+	// includes all rollup IDs and does not censor any.
+	//
+	// This proof together with `Sha256(MTH(rollup_ids))` must match `header.data_hash`.
+	// `MTH(rollup_ids)` is the Merkle Tree Hash derived from the rollup IDs listed in
+	// the rollup transactions.
+	RollupIdsProof *v1.Proof `protobuf:"bytes,6,opt,name=rollup_ids_proof,json=rollupIdsProof,proto3" json:"rollup_ids_proof,omitempty"`
+}
+
+func (x *FilteredSequencerBlock) Reset() {
+	*x = FilteredSequencerBlock{}
+	mi := &file_astria_sequencerblock_v1_block_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilteredSequencerBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilteredSequencerBlock) ProtoMessage() {}
+
+func (x *FilteredSequencerBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_astria_sequencerblock_v1_block_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilteredSequencerBlock.ProtoReflect.Descriptor instead.
+func (*FilteredSequencerBlock) Descriptor() ([]byte, []int) {
+	return file_astria_sequencerblock_v1_block_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FilteredSequencerBlock) GetBlockHash() []byte {
+	if x != nil {
+		return x.BlockHash
+	}
+	return nil
+}
+
+func (x *FilteredSequencerBlock) GetHeader() *SequencerBlockHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *FilteredSequencerBlock) GetRollupTransactions() []*RollupTransactions {
+	if x != nil {
+		return x.RollupTransactions
+	}
+	return nil
+}
+
+func (x *FilteredSequencerBlock) GetRollupTransactionsProof() *v1.Proof {
+	if x != nil {
+		return x.RollupTransactionsProof
+	}
+	return nil
+}
+
+func (x *FilteredSequencerBlock) GetAllRollupIds() []*v1.RollupId {
+	if x != nil {
+		return x.AllRollupIds
+	}
+	return nil
+}
+
+func (x *FilteredSequencerBlock) GetRollupIdsProof() *v1.Proof {
+	if x != nil {
+		return x.RollupIdsProof
+	}
+	return nil
+}
+
+// A piece of data that is sent to a rollup execution node.
+//
+// The data can be either sequenced data (originating from a `SequenceAction`
+// submitted by a user) or a `Deposit` originating from a `BridgeLockAction`.
+//
+// The rollup node receives this type as opaque, protobuf-encoded bytes from conductor,
+// and must decode it accordingly.
+type RollupData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Value:
+	//	*RollupData_SequencedData
+	//	*RollupData_Deposit
+	Value isRollupData_Value `protobuf_oneof:"value"`
+}
+
+func (x *RollupData) Reset() {
+	*x = RollupData{}
+	mi := &file_astria_sequencerblock_v1_block_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollupData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollupData) ProtoMessage() {}
+
+func (x *RollupData) ProtoReflect() protoreflect.Message {
+	mi := &file_astria_sequencerblock_v1_block_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollupData.ProtoReflect.Descriptor instead.
+func (*RollupData) Descriptor() ([]byte, []int) {
+	return file_astria_sequencerblock_v1_block_proto_rawDescGZIP(), []int{5}
+}
+
+func (m *RollupData) GetValue() isRollupData_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (x *RollupData) GetSequencedData() []byte {
+	if x, ok := x.GetValue().(*RollupData_SequencedData); ok {
+		return x.SequencedData
+	}
+	return nil
+}
+
+func (x *RollupData) GetDeposit() *Deposit {
+	if x, ok := x.GetValue().(*RollupData_Deposit); ok {
+		return x.Deposit
+	}
+	return nil
+}
+
+type isRollupData_Value interface {
+	isRollupData_Value()
+}
+
+type RollupData_SequencedData struct {
+	SequencedData []byte `protobuf:"bytes,1,opt,name=sequenced_data,json=sequencedData,proto3,oneof"`
+}
+
+type RollupData_Deposit struct {
+	Deposit *Deposit `protobuf:"bytes,2,opt,name=deposit,proto3,oneof"`
+}
+
+func (*RollupData_SequencedData) isRollupData_Value() {}
+
+func (*RollupData_Deposit) isRollupData_Value() {}
+
+var File_astria_sequencerblock_v1_block_proto protoreflect.FileDescriptor
+
+var file_astria_sequencerblock_v1_block_proto_rawDesc = []byte{
+	0x0a, 0x24, 0x61, 0x73, 0x74, 0x72, 0x69, 0x61, 0x2f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x72, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x18, 0x61, 0x73, 0x74, 0x72, 0x69, 0x61, 0x2e, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x72, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x76, 0x31,
+	0x1a, 0x1f, 0x61, 0x73, 0x74, 0x72, 0x69, 0x61, 0x2f, 0x70, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69,
+	0x76, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xa6, 0x01, 0x0a, 0x12, 0x52, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3a, 0x0a, 0x09, 0x72, 0x6f, 0x6c,
+	0x6c, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61,
+	0x73, 0x74, 0x72, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x49, 0x64, 0x52, 0x08, 0x72, 0x6f, 0x6c,
+	0x6c, 0x75, 0x70, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0c, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x05, 0x70, 0x72, 0x6f,
+	0x6f, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x73, 0x74, 0x72, 0x69,
+	0x61, 0x2e, 0x70, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0xf4, 0x02, 0x0a, 0x0e,
+	0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x46,
+	0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e,
+	0x2e, 0x61, 0x73, 0x74, 0x72, 0x69, 0x61, 0x2e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x72, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x5d, 0x0a, 0x13, 0x72, 0x6f, 0x6c, 0x6c, 0x75, 0x70,
+	0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x61, 0x73, 0x74, 0x72, 0x69, 0x61, 0x2e, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x72, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x12, 0x72, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x56, 0x0a, 0x19, 0x72, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x5f,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x70, 0x72, 0x6f,
+	0x6f, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x73, 0x74, 0x72, 0x69,
+	0x61, 0x2e, 0x70, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x52, 0x17, 0x72, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x44, 0x0a,
+	0x10, 0x72, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x6f,
+	0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x73, 0x74, 0x72, 0x69, 0x61,
+	0x2e, 0x70, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x52, 0x0e, 0x72, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x49, 0x64, 0x73, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61,
+	0x73, 0x68, 0x22, 0xfb, 0x01, 0x0a, 0x14, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x72,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2e,
+	0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x48, 0x61, 0x73, 0x68, 0x12, 0x29, 0x0a, 0x10, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x72, 0x6f, 0x6c, 0x6c, 0x75, 0x70,
+	0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x72, 0x6f,
+	0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x16, 0x72, 0x6f, 0x6c, 0x6c, 0x75, 0x70,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x6f, 0x6f, 0x74,
+	0x22, 0x9a, 0x03, 0x0a, 0x07, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x43, 0x0a, 0x0e,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x73, 0x74, 0x72, 0x69, 0x61, 0x2e, 0x70, 0x72,
+	0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x52, 0x0d, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x3a, 0x0a, 0x09, 0x72, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x73, 0x74, 0x72, 0x69, 0x61, 0x2e, 0x70, 0x72,
+	0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x75,
+	0x70, 0x49, 0x64, 0x52, 0x08, 0x72, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x49, 0x64, 0x12, 0x34, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x61, 0x73, 0x74, 0x72, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x31, 0x32, 0x38, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x3a, 0x0a, 0x19, 0x64, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x56, 0x0a, 0x15, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x73, 0x74, 0x72, 0x69, 0x61, 0x2e, 0x70, 0x72,
+	0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x52, 0x13, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2e, 0x0a,
+	0x13, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xc1, 0x03,
+	0x0a, 0x16, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x46, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x61, 0x73, 0x74, 0x72, 0x69, 0x61,
+	0x2e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x72, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
+	0x5d, 0x0a, 0x13, 0x72, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x61,
+	0x73, 0x74, 0x72, 0x69, 0x61, 0x2e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x72, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x12, 0x72, 0x6f, 0x6c, 0x6c,
+	0x75, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x56,
+	0x0a, 0x19, 0x72, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x73, 0x74, 0x72, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x69, 0x6d, 0x69,
+	0x74, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x17, 0x72,
+	0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x43, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x5f, 0x72, 0x6f,
+	0x6c, 0x6c, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x61, 0x73, 0x74, 0x72, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x49, 0x64, 0x52, 0x0c, 0x61,
+	0x6c, 0x6c, 0x52, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x49, 0x64, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x72,
+	0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x73, 0x74, 0x72, 0x69, 0x61, 0x2e, 0x70,
+	0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x52, 0x0e, 0x72, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x49, 0x64, 0x73, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x22, 0x7d, 0x0a, 0x0a, 0x52, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x27, 0x0a, 0x0e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0d, 0x73, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3d, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x73, 0x74, 0x72,
+	0x69, 0x61, 0x2e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x72, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x48, 0x00, 0x52, 0x07,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x42, 0x70, 0x5a, 0x6e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61,
+	0x73, 0x74, 0x72, 0x69, 0x61, 0x6f, 0x72, 0x67, 0x2f, 0x61, 0x73, 0x74, 0x72, 0x69, 0x61, 0x2d,
+	0x63, 0x6c, 0x69, 0x2d, 0x67, 0x6f, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x2f, 0x67,
+	0x6f, 0x2d, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x72, 0x2d, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x73, 0x74, 0x72, 0x69, 0x61, 0x2f,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x72, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x76,
+	0x31, 0x3b, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x72, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_astria_sequencerblock_v1_block_proto_rawDescOnce sync.Once
+	file_astria_sequencerblock_v1_block_proto_rawDescData = file_astria_sequencerblock_v1_block_proto_rawDesc
+)
+
+func file_astria_sequencerblock_v1_block_proto_rawDescGZIP() []byte {
+	file_astria_sequencerblock_v1_block_proto_rawDescOnce.Do(func() {
+		file_astria_sequencerblock_v1_block_proto_rawDescData = protoimpl.X.CompressGZIP(file_astria_sequencerblock_v1_block_proto_rawDescData)
+	})
+	return file_astria_sequencerblock_v1_block_proto_rawDescData
+}
+
+var file_astria_sequencerblock_v1_block_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_astria_sequencerblock_v1_block_proto_goTypes = []any{
+	(*RollupTransactions)(nil),     // 0: astria.sequencerblock.v1.RollupTransactions
+	(*SequencerBlock)(nil),         // 1: astria.sequencerblock.v1.SequencerBlock
+	(*SequencerBlockHeader)(nil),   // 2: astria.sequencerblock.v1.SequencerBlockHeader
+	(*Deposit)(nil),                // 3: astria.sequencerblock.v1.Deposit
+	(*FilteredSequencerBlock)(nil), // 4: astria.sequencerblock.v1.FilteredSequencerBlock
+	(*RollupData)(nil),             // 5: astria.sequencerblock.v1.RollupData
+	(*v1.RollupId)(nil),            // 6: astria.primitive.v1.RollupId
+	(*v1.Proof)(nil),               // 7: astria.primitive.v1.Proof
+	(*timestamppb.Timestamp)(nil),  // 8: google.protobuf.Timestamp
+	(*v1.Address)(nil),             // 9: astria.primitive.v1.Address
+	(*v1.Uint128)(nil),             // 10: astria.primitive.v1.Uint128
+	(*v1.TransactionId)(nil),       // 11: astria.primitive.v1.TransactionId
+}
+var file_astria_sequencerblock_v1_block_proto_depIdxs = []int32{
+	6,  // 0: astria.sequencerblock.v1.RollupTransactions.rollup_id:type_name -> astria.primitive.v1.RollupId
+	7,  // 1: astria.sequencerblock.v1.RollupTransactions.proof:type_name -> astria.primitive.v1.Proof
+	2,  // 2: astria.sequencerblock.v1.SequencerBlock.header:type_name -> astria.sequencerblock.v1.SequencerBlockHeader
+	0,  // 3: astria.sequencerblock.v1.SequencerBlock.rollup_transactions:type_name -> astria.sequencerblock.v1.RollupTransactions
+	7,  // 4: astria.sequencerblock.v1.SequencerBlock.rollup_transactions_proof:type_name -> astria.primitive.v1.Proof
+	7,  // 5: astria.sequencerblock.v1.SequencerBlock.rollup_ids_proof:type_name -> astria.primitive.v1.Proof
+	8,  // 6: astria.sequencerblock.v1.SequencerBlockHeader.time:type_name -> google.protobuf.Timestamp
+	9,  // 7: astria.sequencerblock.v1.Deposit.bridge_address:type_name -> astria.primitive.v1.Address
+	6,  // 8: astria.sequencerblock.v1.Deposit.rollup_id:type_name -> astria.primitive.v1.RollupId
+	10, // 9: astria.sequencerblock.v1.Deposit.amount:type_name -> astria.primitive.v1.Uint128
+	11, // 10: astria.sequencerblock.v1.Deposit.source_transaction_id:type_name -> astria.primitive.v1.TransactionId
+	2,  // 11: astria.sequencerblock.v1.FilteredSequencerBlock.header:type_name -> astria.sequencerblock.v1.SequencerBlockHeader
+	0,  // 12: astria.sequencerblock.v1.FilteredSequencerBlock.rollup_transactions:type_name -> astria.sequencerblock.v1.RollupTransactions
+	7,  // 13: astria.sequencerblock.v1.FilteredSequencerBlock.rollup_transactions_proof:type_name -> astria.primitive.v1.Proof
+	6,  // 14: astria.sequencerblock.v1.FilteredSequencerBlock.all_rollup_ids:type_name -> astria.primitive.v1.RollupId
+	7,  // 15: astria.sequencerblock.v1.FilteredSequencerBlock.rollup_ids_proof:type_name -> astria.primitive.v1.Proof
+	3,  // 16: astria.sequencerblock.v1.RollupData.deposit:type_name -> astria.sequencerblock.v1.Deposit
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
+}
+
+func init() { file_astria_sequencerblock_v1_block_proto_init() }
+func file_astria_sequencerblock_v1_block_proto_init() {
+	if File_astria_sequencerblock_v1_block_proto != nil {
+		return
+	}
+	file_astria_sequencerblock_v1_block_proto_msgTypes[5].OneofWrappers = []any{
+		(*RollupData_SequencedData)(nil),
+		(*RollupData_Deposit)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_astria_sequencerblock_v1_block_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_astria_sequencerblock_v1_block_proto_goTypes,
+		DependencyIndexes: file_astria_sequencerblock_v1_block_proto_depIdxs,
+		MessageInfos:      file_astria_sequencerblock_v1_block_proto_msgTypes,
+	}.Build()
+	File_astria_sequencerblock_v1_block_proto = out.File
+	file_astria_sequencerblock_v1_block_proto_rawDesc = nil
+	file_astria_sequencerblock_v1_block_proto_goTypes = nil
+	file_astria_sequencerblock_v1_block_proto_depIdxs = nil
+}
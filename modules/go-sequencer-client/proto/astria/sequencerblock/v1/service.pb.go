@@ -0,0 +1,232 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.1
+// 	protoc        (unknown)
+// source: astria/sequencerblock/v1/service.proto
+
+package sequencerblockv1
+
+import (
+	v1 "buf.build/gen/go/astria/primitives/protocolbuffers/go/astria/primitive/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetSequencerBlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The height of the block to retrieve.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *GetSequencerBlockRequest) Reset() {
+	*x = GetSequencerBlockRequest{}
+	mi := &file_astria_sequencerblock_v1_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSequencerBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSequencerBlockRequest) ProtoMessage() {}
+
+func (x *GetSequencerBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_astria_sequencerblock_v1_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSequencerBlockRequest.ProtoReflect.Descriptor instead.
+func (*GetSequencerBlockRequest) Descriptor() ([]byte, []int) {
+	return file_astria_sequencerblock_v1_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetSequencerBlockRequest) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type GetFilteredSequencerBlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The height of the block to retrieve.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// The 32 bytes identifying a rollup. Usually the sha256 hash of a plain rollup name.
+	RollupIds []*v1.RollupId `protobuf:"bytes,2,rep,name=rollup_ids,json=rollupIds,proto3" json:"rollup_ids,omitempty"`
+}
+
+func (x *GetFilteredSequencerBlockRequest) Reset() {
+	*x = GetFilteredSequencerBlockRequest{}
+	mi := &file_astria_sequencerblock_v1_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFilteredSequencerBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFilteredSequencerBlockRequest) ProtoMessage() {}
+
+func (x *GetFilteredSequencerBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_astria_sequencerblock_v1_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFilteredSequencerBlockRequest.ProtoReflect.Descriptor instead.
+func (*GetFilteredSequencerBlockRequest) Descriptor() ([]byte, []int) {
+	return file_astria_sequencerblock_v1_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetFilteredSequencerBlockRequest) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *GetFilteredSequencerBlockRequest) GetRollupIds() []*v1.RollupId {
+	if x != nil {
+		return x.RollupIds
+	}
+	return nil
+}
+
+var File_astria_sequencerblock_v1_service_proto protoreflect.FileDescriptor
+
+var file_astria_sequencerblock_v1_service_proto_rawDesc = []byte{
+	0x0a, 0x26, 0x61, 0x73, 0x74, 0x72, 0x69, 0x61, 0x2f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x72, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x18, 0x61, 0x73, 0x74, 0x72, 0x69, 0x61,
+	0x2e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x72, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e,
+	0x76, 0x31, 0x1a, 0x1f, 0x61, 0x73, 0x74, 0x72, 0x69, 0x61, 0x2f, 0x70, 0x72, 0x69, 0x6d, 0x69,
+	0x74, 0x69, 0x76, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x24, 0x61, 0x73, 0x74, 0x72, 0x69, 0x61, 0x2f, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x72, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x32, 0x0a, 0x18, 0x47, 0x65, 0x74,
+	0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x78, 0x0a,
+	0x20, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x53, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x3c, 0x0a, 0x0a, 0x72, 0x6f, 0x6c,
+	0x6c, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x61, 0x73, 0x74, 0x72, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x49, 0x64, 0x52, 0x09, 0x72, 0x6f,
+	0x6c, 0x6c, 0x75, 0x70, 0x49, 0x64, 0x73, 0x32, 0x91, 0x02, 0x0a, 0x10, 0x53, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x71, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x32, 0x2e, 0x61, 0x73, 0x74, 0x72, 0x69, 0x61, 0x2e, 0x73, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x72, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x73, 0x74, 0x72, 0x69, 0x61, 0x2e, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x72, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
+	0x89, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x53,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x3a, 0x2e,
+	0x61, 0x73, 0x74, 0x72, 0x69, 0x61, 0x2e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x72,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x65, 0x64, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x72, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x61, 0x73, 0x74, 0x72,
+	0x69, 0x61, 0x2e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x72, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x53, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x70, 0x5a, 0x6e, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x73, 0x74, 0x72, 0x69, 0x61,
+	0x6f, 0x72, 0x67, 0x2f, 0x61, 0x73, 0x74, 0x72, 0x69, 0x61, 0x2d, 0x63, 0x6c, 0x69, 0x2d, 0x67,
+	0x6f, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x2f, 0x67, 0x6f, 0x2d, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x72, 0x2d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x73, 0x74, 0x72, 0x69, 0x61, 0x2f, 0x73, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x72, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x72, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x76, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_astria_sequencerblock_v1_service_proto_rawDescOnce sync.Once
+	file_astria_sequencerblock_v1_service_proto_rawDescData = file_astria_sequencerblock_v1_service_proto_rawDesc
+)
+
+func file_astria_sequencerblock_v1_service_proto_rawDescGZIP() []byte {
+	file_astria_sequencerblock_v1_service_proto_rawDescOnce.Do(func() {
+		file_astria_sequencerblock_v1_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_astria_sequencerblock_v1_service_proto_rawDescData)
+	})
+	return file_astria_sequencerblock_v1_service_proto_rawDescData
+}
+
+var file_astria_sequencerblock_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_astria_sequencerblock_v1_service_proto_goTypes = []any{
+	(*GetSequencerBlockRequest)(nil),         // 0: astria.sequencerblock.v1.GetSequencerBlockRequest
+	(*GetFilteredSequencerBlockRequest)(nil), // 1: astria.sequencerblock.v1.GetFilteredSequencerBlockRequest
+	(*v1.RollupId)(nil),                      // 2: astria.primitive.v1.RollupId
+	(*SequencerBlock)(nil),                   // 3: astria.sequencerblock.v1.SequencerBlock
+	(*FilteredSequencerBlock)(nil),           // 4: astria.sequencerblock.v1.FilteredSequencerBlock
+}
+var file_astria_sequencerblock_v1_service_proto_depIdxs = []int32{
+	2, // 0: astria.sequencerblock.v1.GetFilteredSequencerBlockRequest.rollup_ids:type_name -> astria.primitive.v1.RollupId
+	0, // 1: astria.sequencerblock.v1.SequencerService.GetSequencerBlock:input_type -> astria.sequencerblock.v1.GetSequencerBlockRequest
+	1, // 2: astria.sequencerblock.v1.SequencerService.GetFilteredSequencerBlock:input_type -> astria.sequencerblock.v1.GetFilteredSequencerBlockRequest
+	3, // 3: astria.sequencerblock.v1.SequencerService.GetSequencerBlock:output_type -> astria.sequencerblock.v1.SequencerBlock
+	4, // 4: astria.sequencerblock.v1.SequencerService.GetFilteredSequencerBlock:output_type -> astria.sequencerblock.v1.FilteredSequencerBlock
+	3, // [3:5] is the sub-list for method output_type
+	1, // [1:3] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_astria_sequencerblock_v1_service_proto_init() }
+func file_astria_sequencerblock_v1_service_proto_init() {
+	if File_astria_sequencerblock_v1_service_proto != nil {
+		return
+	}
+	file_astria_sequencerblock_v1_block_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_astria_sequencerblock_v1_service_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_astria_sequencerblock_v1_service_proto_goTypes,
+		DependencyIndexes: file_astria_sequencerblock_v1_service_proto_depIdxs,
+		MessageInfos:      file_astria_sequencerblock_v1_service_proto_msgTypes,
+	}.Build()
+	File_astria_sequencerblock_v1_service_proto = out.File
+	file_astria_sequencerblock_v1_service_proto_rawDesc = nil
+	file_astria_sequencerblock_v1_service_proto_goTypes = nil
+	file_astria_sequencerblock_v1_service_proto_depIdxs = nil
+}
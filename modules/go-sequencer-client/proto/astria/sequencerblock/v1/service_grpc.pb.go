@@ -0,0 +1,165 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: astria/sequencerblock/v1/service.proto
+
+package sequencerblockv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SequencerService_GetSequencerBlock_FullMethodName         = "/astria.sequencerblock.v1.SequencerService/GetSequencerBlock"
+	SequencerService_GetFilteredSequencerBlock_FullMethodName = "/astria.sequencerblock.v1.SequencerService/GetFilteredSequencerBlock"
+)
+
+// SequencerServiceClient is the client API for SequencerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SequencerServiceClient interface {
+	// Given a block height, returns the sequencer block at that height.
+	GetSequencerBlock(ctx context.Context, in *GetSequencerBlockRequest, opts ...grpc.CallOption) (*SequencerBlock, error)
+	// Given a block height and set of rollup ids, returns a SequencerBlock which
+	// is filtered to contain only the transactions that are relevant to the given rollup.
+	GetFilteredSequencerBlock(ctx context.Context, in *GetFilteredSequencerBlockRequest, opts ...grpc.CallOption) (*FilteredSequencerBlock, error)
+}
+
+type sequencerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSequencerServiceClient(cc grpc.ClientConnInterface) SequencerServiceClient {
+	return &sequencerServiceClient{cc}
+}
+
+func (c *sequencerServiceClient) GetSequencerBlock(ctx context.Context, in *GetSequencerBlockRequest, opts ...grpc.CallOption) (*SequencerBlock, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SequencerBlock)
+	err := c.cc.Invoke(ctx, SequencerService_GetSequencerBlock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sequencerServiceClient) GetFilteredSequencerBlock(ctx context.Context, in *GetFilteredSequencerBlockRequest, opts ...grpc.CallOption) (*FilteredSequencerBlock, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FilteredSequencerBlock)
+	err := c.cc.Invoke(ctx, SequencerService_GetFilteredSequencerBlock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SequencerServiceServer is the server API for SequencerService service.
+// All implementations must embed UnimplementedSequencerServiceServer
+// for forward compatibility.
+type SequencerServiceServer interface {
+	// Given a block height, returns the sequencer block at that height.
+	GetSequencerBlock(context.Context, *GetSequencerBlockRequest) (*SequencerBlock, error)
+	// Given a block height and set of rollup ids, returns a SequencerBlock which
+	// is filtered to contain only the transactions that are relevant to the given rollup.
+	GetFilteredSequencerBlock(context.Context, *GetFilteredSequencerBlockRequest) (*FilteredSequencerBlock, error)
+	mustEmbedUnimplementedSequencerServiceServer()
+}
+
+// UnimplementedSequencerServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSequencerServiceServer struct{}
+
+func (UnimplementedSequencerServiceServer) GetSequencerBlock(context.Context, *GetSequencerBlockRequest) (*SequencerBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSequencerBlock not implemented")
+}
+func (UnimplementedSequencerServiceServer) GetFilteredSequencerBlock(context.Context, *GetFilteredSequencerBlockRequest) (*FilteredSequencerBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFilteredSequencerBlock not implemented")
+}
+func (UnimplementedSequencerServiceServer) mustEmbedUnimplementedSequencerServiceServer() {}
+func (UnimplementedSequencerServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeSequencerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SequencerServiceServer will
+// result in compilation errors.
+type UnsafeSequencerServiceServer interface {
+	mustEmbedUnimplementedSequencerServiceServer()
+}
+
+func RegisterSequencerServiceServer(s grpc.ServiceRegistrar, srv SequencerServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSequencerServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SequencerService_ServiceDesc, srv)
+}
+
+func _SequencerService_GetSequencerBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSequencerBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SequencerServiceServer).GetSequencerBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SequencerService_GetSequencerBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SequencerServiceServer).GetSequencerBlock(ctx, req.(*GetSequencerBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SequencerService_GetFilteredSequencerBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFilteredSequencerBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SequencerServiceServer).GetFilteredSequencerBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SequencerService_GetFilteredSequencerBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SequencerServiceServer).GetFilteredSequencerBlock(ctx, req.(*GetFilteredSequencerBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SequencerService_ServiceDesc is the grpc.ServiceDesc for SequencerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SequencerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "astria.sequencerblock.v1.SequencerService",
+	HandlerType: (*SequencerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSequencerBlock",
+			Handler:    _SequencerService_GetSequencerBlock_Handler,
+		},
+		{
+			MethodName: "GetFilteredSequencerBlock",
+			Handler:    _SequencerService_GetFilteredSequencerBlock_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "astria/sequencerblock/v1/service.proto",
+}